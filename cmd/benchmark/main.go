@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"google.golang.org/grpc"
+	"log"
+	"os"
+	"sni/protos/sni"
+	"strconv"
+	"strings"
+)
+
+// benchmark asks a running SNI to measure the throughput of a serial device at different baud rates; SNI remembers
+// the best reliable rate for the device and uses it from then on.
+func main() {
+	addr := flag.String("addr", "localhost:8191", "host:port of the SNI gRPC service")
+	uri := flag.String("uri", "", "URI of the device to benchmark; lists devices if empty")
+	rates := flag.String("rates", "", "comma-delimited list of baud rates to try; defaults to all rates the driver supports")
+	durationMs := flag.Uint("ms", 2000, "how long to transfer data at each baud rate in milliseconds")
+	flag.Parse()
+
+	var err error
+
+	var conn *grpc.ClientConn
+	conn, err = grpc.Dial(*addr, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("fail to dial: %v", err)
+	}
+	defer conn.Close()
+
+	client := sni.NewDevicesClient(conn)
+	if *uri == "" {
+		var rsp *sni.DevicesResponse
+		rsp, err = client.ListDevices(context.Background(), &sni.DevicesRequest{})
+		if err != nil {
+			log.Fatalf("fail to list devices: %v", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "specify one of the devices with -uri:\n")
+		for _, device := range rsp.Devices {
+			fmt.Printf("%s\t%s\n", device.Uri, device.DisplayName)
+		}
+		return
+	}
+
+	request := &sni.BenchmarkDeviceRequest{Uri: *uri, DurationMs: uint32(*durationMs)}
+	for _, s := range strings.Split(*rates, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+
+		var baud int
+		baud, err = strconv.Atoi(s)
+		if err != nil {
+			log.Fatalf("invalid baud rate '%s': %v", s, err)
+		}
+		request.BaudRates = append(request.BaudRates, uint32(baud))
+	}
+
+	var rsp *sni.BenchmarkDeviceResponse
+	rsp, err = client.BenchmarkDevice(context.Background(), request)
+	if err != nil {
+		log.Fatalf("fail to benchmark device: %v", err)
+	}
+
+	fmt.Printf("%8s %12s %10s %7s  %s\n", "baud", "bytes/s", "transfers", "errors", "error")
+	for _, result := range rsp.Results {
+		fmt.Printf(
+			"%8d %12.0f %10d %7d  %s\n",
+			result.BaudRate,
+			result.BytesPerSecond,
+			result.Transfers,
+			result.Errors,
+			result.Error,
+		)
+	}
+
+	if rsp.BestBaudRate == 0 {
+		fmt.Printf("no baud rate worked reliably\n")
+		os.Exit(1)
+	}
+	fmt.Printf("best baud rate: %d\n", rsp.BestBaudRate)
+}
@@ -16,6 +16,7 @@ import (
 	"sni/devices/snes/drivers/mock"
 	"sni/devices/snes/drivers/retroarch"
 	"sni/services/grpcimpl"
+	"sni/services/nwa"
 	"sni/services/usb2snes"
 )
 
@@ -94,6 +95,7 @@ func main() {
 	// start the servers:
 	grpcimpl.StartGrpcServer()
 	usb2snes.StartHttpServer()
+	nwa.StartServer()
 
 	// start up a systray:
 	tray.CreateSystray()
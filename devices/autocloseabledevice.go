@@ -3,6 +3,7 @@ package devices
 import (
 	"context"
 	"fmt"
+	"github.com/alttpo/snes/timing"
 	"google.golang.org/grpc/codes"
 	"io"
 	"log"
@@ -18,9 +19,18 @@ type AutoCloseableDevice interface {
 	io.Closer
 	DeviceControl
 	DeviceMemory
+	DeviceMemoryStreamer
+	DeviceMemoryRegions
 	DeviceFilesystem
+	DeviceBootRom
 	DeviceInfo
+	DeviceASM
 	DeviceNWA
+	DeviceNWAControl
+	DeviceSaveStates
+	DeviceScreenshot
+	DeviceEmulationControl
+	DeviceEmulationState
 
 	URI() *url.URL
 	DeviceKey() string
@@ -236,6 +246,25 @@ func (a *autoCloseableDevice) MultiWriteMemory(ctx context.Context, writes ...Me
 	return
 }
 
+func (a *autoCloseableDevice) StreamReadMemory(ctx context.Context, frame MemoryStreamFunc, reads ...MemoryReadRequest) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		if a.logger != nil {
+			a.logger.Printf("StreamReadMemory(%#v) {\n", reads)
+		}
+		if streamer, ok := device.(DeviceMemoryStreamer); ok {
+			err = streamer.StreamReadMemory(ctx, frame, reads...)
+		} else {
+			// fall back to polling once per frame:
+			err = PollReadMemory(ctx, device, timing.Frame, frame, reads...)
+		}
+		if a.logger != nil {
+			a.logger.Printf("StreamReadMemory(%#v) } -> (%#v)\n", reads, err)
+		}
+		return
+	})
+	return
+}
+
 func (a *autoCloseableDevice) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		inf, ok := device.(DeviceInfo)
@@ -380,6 +409,168 @@ func (a *autoCloseableDevice) BootFile(ctx context.Context, path string) (err er
 	return
 }
 
+func (a *autoCloseableDevice) BootRom(ctx context.Context, source BootRomSource) (path string, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		boot, ok := device.(DeviceBootRom)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceBootRom not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("BootRom(%#v, %#v, rom=%d bytes) {\n", source.FileName, source.HostPath, len(source.Rom))
+		}
+		path, err = boot.BootRom(ctx, source)
+		if a.logger != nil {
+			a.logger.Printf("BootRom(%#v, %#v, rom=%d bytes) } -> (%#v, %#v)\n", source.FileName, source.HostPath, len(source.Rom), path, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) ExecuteASM(ctx context.Context, code []byte, data []byte, resultSize int) (result []byte, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		exe, ok := device.(DeviceASM)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceASM not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(code=%d bytes, data=%d bytes, %#v) {\n", len(code), len(data), resultSize)
+		}
+		result, err = exe.ExecuteASM(ctx, code, data, resultSize)
+		if a.logger != nil {
+			a.logger.Printf("ExecuteASM(code=%d bytes, data=%d bytes, %#v) } -> (%#v, %#v)\n", len(code), len(data), resultSize, result, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) SaveState(ctx context.Context, target SaveStateTarget) (state []byte, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		states, ok := device.(DeviceSaveStates)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceSaveStates not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("SaveState(slot=%d, path=%#v, transfer=%#v) {\n", target.Slot, target.Path, target.Transfer)
+		}
+		state, err = states.SaveState(ctx, target)
+		if a.logger != nil {
+			a.logger.Printf("SaveState(slot=%d, path=%#v, transfer=%#v) } -> (%d bytes, %#v)\n", target.Slot, target.Path, target.Transfer, len(state), err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) LoadState(ctx context.Context, target SaveStateTarget) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		states, ok := device.(DeviceSaveStates)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceSaveStates not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("LoadState(slot=%d, path=%#v, state=%d bytes) {\n", target.Slot, target.Path, len(target.State))
+		}
+		err = states.LoadState(ctx, target)
+		if a.logger != nil {
+			a.logger.Printf("LoadState(slot=%d, path=%#v, state=%d bytes) } -> %#v\n", target.Slot, target.Path, len(target.State), err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) Screenshot(ctx context.Context) (image []byte, mimeType string, path string, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		screenshot, ok := device.(DeviceScreenshot)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceScreenshot not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("Screenshot() {\n")
+		}
+		image, mimeType, path, err = screenshot.Screenshot(ctx)
+		if a.logger != nil {
+			a.logger.Printf("Screenshot() } -> (%d bytes, %#v, %#v, %#v)\n", len(image), mimeType, path, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) FrameAdvance(ctx context.Context, frames int) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulationControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulationControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("FrameAdvance(%d) {\n", frames)
+		}
+		err = emu.FrameAdvance(ctx, frames)
+		if a.logger != nil {
+			a.logger.Printf("FrameAdvance(%d) } -> %#v\n", frames, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) SetEmulationSpeed(ctx context.Context, speed float64) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulationControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulationControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("SetEmulationSpeed(%v) {\n", speed)
+		}
+		err = emu.SetEmulationSpeed(ctx, speed)
+		if a.logger != nil {
+			a.logger.Printf("SetEmulationSpeed(%v) } -> %#v\n", speed, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) ListMemoryRegions(ctx context.Context) (regions []MemoryRegion, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		lister, ok := device.(DeviceMemoryRegions)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceMemoryRegions not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("ListMemoryRegions() {\n")
+		}
+		regions, err = lister.ListMemoryRegions(ctx)
+		if a.logger != nil {
+			a.logger.Printf("ListMemoryRegions() } -> (%+v, %#v)\n", regions, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) GetEmulationState(ctx context.Context) (status EmulationStatus, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		emu, ok := device.(DeviceEmulationState)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceEmulationState not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("GetEmulationState() {\n")
+		}
+		status, err = emu.GetEmulationState(ctx)
+		if a.logger != nil {
+			a.logger.Printf("GetEmulationState() } -> (%+v, %#v)\n", status, err)
+		}
+		return
+	})
+	return
+}
+
 func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error) {
 	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
 		nwa, ok := device.(DeviceNWA)
@@ -389,7 +580,7 @@ func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args s
 		if a.logger != nil {
 			a.logger.Printf("NWACommand(%#v, %#v, binary=%#v (%d bytes)) {\n", cmd, args, binaryArg != nil, len(binaryArg))
 		}
-		asciiReply, binaryArg, err = nwa.NWACommand(ctx, cmd, args, binaryArg)
+		asciiReply, binaryReply, err = nwa.NWACommand(ctx, cmd, args, binaryArg)
 		if a.logger != nil {
 			a.logger.Printf("NWACommand(%#v, %#v, binary=%#v (%d bytes)) } -> (%#v, binary=%#v (%d bytes), %#v)\n",
 				cmd, args, binaryArg != nil, len(binaryArg),
@@ -400,3 +591,129 @@ func (a *autoCloseableDevice) NWACommand(ctx context.Context, cmd string, args s
 	})
 	return
 }
+
+func (a *autoCloseableDevice) NWAEmulatorInfo(ctx context.Context) (info NWAEmulatorInfo, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWAEmulatorInfo() {\n")
+		}
+		info, err = nwa.NWAEmulatorInfo(ctx)
+		if a.logger != nil {
+			a.logger.Printf("NWAEmulatorInfo() } -> (%+v, %#v)\n", info, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWACoresList(ctx context.Context, platform string) (cores []NWACore, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWACoresList(%#v) {\n", platform)
+		}
+		cores, err = nwa.NWACoresList(ctx, platform)
+		if a.logger != nil {
+			a.logger.Printf("NWACoresList(%#v) } -> (%+v, %#v)\n", platform, cores, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWACoreInfo(ctx context.Context, name string) (core NWACore, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWACoreInfo(%#v) {\n", name)
+		}
+		core, err = nwa.NWACoreInfo(ctx, name)
+		if a.logger != nil {
+			a.logger.Printf("NWACoreInfo(%#v) } -> (%+v, %#v)\n", name, core, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWALoadCore(ctx context.Context, name string) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWALoadCore(%#v) {\n", name)
+		}
+		err = nwa.NWALoadCore(ctx, name)
+		if a.logger != nil {
+			a.logger.Printf("NWALoadCore(%#v) } -> %#v\n", name, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWACoreMemories(ctx context.Context) (memories []NWACoreMemory, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWACoreMemories() {\n")
+		}
+		memories, err = nwa.NWACoreMemories(ctx)
+		if a.logger != nil {
+			a.logger.Printf("NWACoreMemories() } -> (%+v, %#v)\n", memories, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWALoadGame(ctx context.Context, path string) (err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWALoadGame(%#v) {\n", path)
+		}
+		err = nwa.NWALoadGame(ctx, path)
+		if a.logger != nil {
+			a.logger.Printf("NWALoadGame(%#v) } -> %#v\n", path, err)
+		}
+		return
+	})
+	return
+}
+
+func (a *autoCloseableDevice) NWAGameInfo(ctx context.Context) (info NWAGameInfo, err error) {
+	err = a.ensureOpened(ctx, func(ctx context.Context, device Device) (err error) {
+		nwa, ok := device.(DeviceNWAControl)
+		if !ok {
+			return WithCode(codes.Unimplemented, fmt.Errorf("DeviceNWAControl not implemented"))
+		}
+		if a.logger != nil {
+			a.logger.Printf("NWAGameInfo() {\n")
+		}
+		info, err = nwa.NWAGameInfo(ctx)
+		if a.logger != nil {
+			a.logger.Printf("NWAGameInfo() } -> (%+v, %#v)\n", info, err)
+		}
+		return
+	})
+	return
+}
@@ -0,0 +1,92 @@
+package devices
+
+import (
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// defaultBootRomFileName names ROMs booted from their contents without a file name:
+const defaultBootRomFileName = "sni-boot.sfc"
+
+// bootRomExtensions are the file extensions a HostPath may have so that BootRom cannot be used to read other files:
+var bootRomExtensions = []string{".sfc", ".smc"}
+
+// checkHostPath refuses a HostPath that does not name a ROM file:
+func (s BootRomSource) checkHostPath() error {
+	ext := strings.ToLower(filepath.Ext(s.HostPath))
+	for _, romExt := range bootRomExtensions {
+		if ext == romExt {
+			return nil
+		}
+	}
+	return WithCode(
+		codes.InvalidArgument,
+		fmt.Errorf("boot rom: hostPath must name a ROM file ending in one of %s", strings.Join(bootRomExtensions, ", ")),
+	)
+}
+
+// Name returns the file name to give the ROM on the device, stripped of any directories.
+func (s BootRomSource) Name() string {
+	name := s.FileName
+	if name == "" && s.HostPath != "" {
+		name = filepath.Base(s.HostPath)
+	}
+	// keep only the last element of either kind of path:
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	if name == "" || name == "." || name == ".." {
+		name = defaultBootRomFileName
+	}
+	return name
+}
+
+// Contents returns the contents of the ROM, reading HostPath if set.
+func (s BootRomSource) Contents() (rom []byte, err error) {
+	if s.HostPath == "" {
+		if len(s.Rom) == 0 {
+			err = WithCode(codes.InvalidArgument, fmt.Errorf("boot rom: either rom or hostPath is required"))
+			return
+		}
+		rom = s.Rom
+		return
+	}
+
+	if err = s.checkHostPath(); err != nil {
+		return
+	}
+	rom, err = os.ReadFile(s.HostPath)
+	if err != nil {
+		err = WithCode(codes.NotFound, fmt.Errorf("boot rom: %w", err))
+	}
+	return
+}
+
+// LocalPath returns the path of the ROM on SNI's host for emulators running on the same host, writing its contents to
+// a temporary directory unless HostPath is set. The file is kept so that the emulator can load it again on reset.
+func (s BootRomSource) LocalPath() (path string, err error) {
+	if s.HostPath != "" {
+		if err = s.checkHostPath(); err != nil {
+			return
+		}
+		path, err = filepath.Abs(s.HostPath)
+		return
+	}
+
+	var rom []byte
+	rom, err = s.Contents()
+	if err != nil {
+		return
+	}
+
+	dir := filepath.Join(os.TempDir(), "sni-boot")
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return
+	}
+	path = filepath.Join(dir, s.Name())
+	err = os.WriteFile(path, rom, 0644)
+	return
+}
@@ -0,0 +1,40 @@
+package devices
+
+import (
+	"bytes"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBootRomSource_Contents(t *testing.T) {
+	dir := t.TempDir()
+	rom := []byte{0x5C, 0x00, 0x80, 0x00}
+	for _, name := range []string{"seed.sfc", "seed.SMC", "id_rsa"} {
+		if err := os.WriteFile(filepath.Join(dir, name), rom, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"seed.sfc", "seed.SMC"} {
+		got, err := BootRomSource{HostPath: filepath.Join(dir, name)}.Contents()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, rom) {
+			t.Errorf("Contents() of %s = %x, want %x", name, got, rom)
+		}
+	}
+
+	// only ROM files can be read from SNI's host:
+	var coded *CodedError
+	source := BootRomSource{HostPath: filepath.Join(dir, "id_rsa")}
+	if _, err := source.Contents(); !errors.As(err, &coded) || coded.Code != codes.InvalidArgument {
+		t.Errorf("Contents() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+	if _, err := source.LocalPath(); !errors.As(err, &coded) || coded.Code != codes.InvalidArgument {
+		t.Errorf("LocalPath() error = %v, want code %v", err, codes.InvalidArgument)
+	}
+}
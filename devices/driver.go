@@ -10,6 +10,8 @@ import (
 var (
 	driversMu sync.RWMutex
 	drivers   = make(map[string]Driver)
+	// names of drivers keyed by the additional URI schemes they handle:
+	schemes = make(map[string]string)
 )
 
 type NamedDriver struct {
@@ -41,11 +43,27 @@ func Register(name string, driver Driver) {
 	drivers[name] = driver
 }
 
+// RegisterScheme makes device URIs with the provided scheme resolve to the driver registered by name, in addition to
+// URIs with the driver's own name as their scheme.
+// If RegisterScheme is called twice with the same scheme or if scheme is the name of a driver, it panics.
+func RegisterScheme(scheme string, name string) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	if _, dup := drivers[scheme]; dup {
+		panic("snes: RegisterScheme called with the name of driver " + scheme)
+	}
+	if _, dup := schemes[scheme]; dup {
+		panic("snes: RegisterScheme called twice for scheme " + scheme)
+	}
+	schemes[scheme] = name
+}
+
 func unregisterAllDrivers() {
 	driversMu.Lock()
 	defer driversMu.Unlock()
 	// For tests.
 	drivers = make(map[string]Driver)
+	schemes = make(map[string]string)
 }
 
 // Drivers returns a list of the registered drivers.
@@ -91,7 +109,13 @@ func DriverByName(name string) (Driver, bool) {
 func DeviceDriverByUri(uri *url.URL) (drv Driver, err error) {
 	var ok bool
 	var gendrv Driver
-	gendrv, ok = DriverByName(uri.Scheme)
+	name := uri.Scheme
+	driversMu.RLock()
+	if alias, isAlias := schemes[name]; isAlias {
+		name = alias
+	}
+	driversMu.RUnlock()
+	gendrv, ok = DriverByName(name)
 	if !ok {
 		err = fmt.Errorf("driver not found by name '%s'", uri.Scheme)
 		return
@@ -0,0 +1,118 @@
+package devices
+
+import (
+	"fmt"
+	"github.com/spf13/cast"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// maxEndpointPortRange limits how many endpoints a single port range may expand to:
+const maxEndpointPortRange = 256
+
+// Endpoint is a network address a driver probes to detect devices, e.g. an emulator listening for network commands
+type Endpoint struct {
+	// Address is a host:port pair
+	Address string
+	// Name is an optional display name for devices detected at Address
+	Name string
+}
+
+// ParseEndpoints parses a list of endpoints from a config value which is either a comma-delimited string or a list
+// whose elements are strings or maps with "host", "port" and "name" keys. Strings are host:port pairs, e.g.
+// "localhost:55355" or "[::1]:55355"; ports may be ranges, e.g. "localhost:55355-55358", which expand to one
+// endpoint per port. Duplicate addresses are dropped.
+func ParseEndpoints(value interface{}) (endpoints []Endpoint, err error) {
+	var items []interface{}
+	switch v := value.(type) {
+	case nil:
+		return
+	case string:
+		for _, s := range strings.Split(v, ",") {
+			items = append(items, s)
+		}
+	default:
+		items, err = cast.ToSliceE(value)
+		if err != nil {
+			err = fmt.Errorf("endpoints: expected a list: %w", err)
+			return
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range items {
+		var hostport, name string
+		if s, ok := item.(string); ok {
+			hostport = strings.TrimSpace(s)
+			if hostport == "" {
+				continue
+			}
+		} else {
+			var m map[string]interface{}
+			m, err = cast.ToStringMapE(item)
+			if err != nil {
+				err = fmt.Errorf("endpoints: expected a host:port string or a map: %w", err)
+				return
+			}
+			hostport = net.JoinHostPort(cast.ToString(m["host"]), cast.ToString(m["port"]))
+			name = cast.ToString(m["name"])
+		}
+
+		var expanded []Endpoint
+		expanded, err = expandEndpoint(hostport, name)
+		if err != nil {
+			return
+		}
+		for _, endpoint := range expanded {
+			if seen[endpoint.Address] {
+				continue
+			}
+			seen[endpoint.Address] = true
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return
+}
+
+func expandEndpoint(hostport string, name string) (endpoints []Endpoint, err error) {
+	var host, ports string
+	host, ports, err = net.SplitHostPort(hostport)
+	if err != nil {
+		err = fmt.Errorf("endpoints: '%s': %w", hostport, err)
+		return
+	}
+	if host == "" {
+		err = fmt.Errorf("endpoints: '%s': missing host", hostport)
+		return
+	}
+
+	first, last := ports, ports
+	if i := strings.IndexByte(ports, '-'); i >= 0 {
+		first, last = ports[:i], ports[i+1:]
+	}
+
+	var lo, hi uint64
+	if lo, err = strconv.ParseUint(strings.TrimSpace(first), 0, 16); err != nil {
+		err = fmt.Errorf("endpoints: '%s': invalid port: %w", hostport, err)
+		return
+	}
+	if hi, err = strconv.ParseUint(strings.TrimSpace(last), 0, 16); err != nil {
+		err = fmt.Errorf("endpoints: '%s': invalid port: %w", hostport, err)
+		return
+	}
+	if hi < lo || hi-lo >= maxEndpointPortRange {
+		err = fmt.Errorf("endpoints: '%s': port range must be ascending and at most %d ports", hostport, maxEndpointPortRange)
+		return
+	}
+
+	endpoints = make([]Endpoint, 0, hi-lo+1)
+	for port := lo; port <= hi; port++ {
+		endpoints = append(endpoints, Endpoint{
+			Address: net.JoinHostPort(host, strconv.FormatUint(port, 10)),
+			Name:    name,
+		})
+	}
+	return
+}
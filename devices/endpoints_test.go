@@ -0,0 +1,67 @@
+package devices
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseEndpoints(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    []Endpoint
+		wantErr bool
+	}{
+		{
+			name:  "comma-delimited",
+			value: "localhost:55355, [::1]:55355",
+			want: []Endpoint{
+				{Address: "localhost:55355"},
+				{Address: "[::1]:55355"},
+			},
+		},
+		{
+			name:  "port range",
+			value: []interface{}{"localhost:0xbeef-48881"},
+			want: []Endpoint{
+				{Address: "localhost:48879"},
+				{Address: "localhost:48880"},
+				{Address: "localhost:48881"},
+			},
+		},
+		{
+			name: "named map and duplicates",
+			value: []interface{}{
+				map[string]interface{}{"host": "192.168.1.20", "port": 55355, "name": "Living room"},
+				"192.168.1.20:55355",
+				map[interface{}]interface{}{"host": "::1", "port": "55356-55357"},
+			},
+			want: []Endpoint{
+				{Address: "192.168.1.20:55355", Name: "Living room"},
+				{Address: "[::1]:55356"},
+				{Address: "[::1]:55357"},
+			},
+		},
+		{
+			name:    "missing port",
+			value:   "localhost",
+			wantErr: true,
+		},
+		{
+			name:    "descending range",
+			value:   "localhost:55358-55355",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEndpoints(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseEndpoints() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("ParseEndpoints() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
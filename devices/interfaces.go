@@ -5,6 +5,7 @@ import (
 	"io"
 	"net/url"
 	"sni/protos/sni"
+	"time"
 )
 
 type Driver interface {
@@ -22,6 +23,30 @@ type Driver interface {
 	HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error)
 }
 
+// DriverBaudRateBenchmarker is implemented by drivers of serial devices that can measure the throughput of a device
+// at different baud rates and remember the best reliable rate for it.
+type DriverBaudRateBenchmarker interface {
+	// BenchmarkBaudRates transfers data from the device at uri for duration at each of baudRates (or the driver's
+	// default candidates if empty) and returns the fastest rate without errors for the device as best. The device is
+	// disconnected and cannot be reopened while benchmarking. best is 0 if no rate worked reliably.
+	BenchmarkBaudRates(ctx context.Context, uri *url.URL, baudRates []int, duration time.Duration) (results []BaudRateResult, best int, err error)
+
+	// RememberBaudRate records baud in the configuration as the rate to open the device at uri with from then on;
+	// the caller is responsible for saving the configuration.
+	RememberBaudRate(uri *url.URL, baud int)
+}
+
+// BaudRateResult is the outcome of benchmarking a device at a single baud rate.
+type BaudRateResult struct {
+	BaudRate       int
+	BytesPerSecond float64
+	// Transfers is the number of completed read requests and Errors the number of those that returned bad data
+	Transfers int
+	Errors    int
+	// Err is set if the device could not be opened or failed at this rate
+	Err error
+}
+
 type DeviceDescriptor struct {
 	Uri                 url.URL
 	DisplayName         string
@@ -29,6 +54,9 @@ type DeviceDescriptor struct {
 	Capabilities        []sni.DeviceCapability
 	DefaultAddressSpace sni.AddressSpace
 	System              string
+
+	// Id is a stable identity of the physical device that survives reconnects, if the driver can determine one
+	Id string
 }
 
 // Device acts as an exclusive-access gateway to the subsystems of the SNES device
@@ -47,6 +75,31 @@ type DeviceMemory interface {
 	MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) ([]MemoryWriteResponse, error)
 }
 
+// DeviceMemoryStreamer is implemented by devices that can natively stream memory reads without a request per frame
+type DeviceMemoryStreamer interface {
+	// StreamReadMemory reads the requested memory continuously and calls frame with each new set of responses until
+	// ctx is canceled or frame returns an error. The responses passed to frame are only valid until frame returns.
+	StreamReadMemory(ctx context.Context, frame MemoryStreamFunc, reads ...MemoryReadRequest) error
+}
+
+type MemoryStreamFunc func(rsp []MemoryReadResponse) error
+
+// DeviceMemoryRegions is implemented by devices that can describe the memory regions they can read or write
+type DeviceMemoryRegions interface {
+	ListMemoryRegions(ctx context.Context) (regions []MemoryRegion, err error)
+}
+
+type MemoryRegion struct {
+	Name string
+	// Address is the start of the region in AddressSpace for MultiReadMemory and MultiWriteMemory
+	Address      uint32
+	AddressSpace sni.AddressSpace
+	Size         uint32
+
+	Readable bool
+	Writable bool
+}
+
 type MemoryReadRequest struct {
 	RequestAddress AddressTuple
 
@@ -71,6 +124,9 @@ type MemoryWriteResponse struct {
 	DeviceAddress  AddressTuple
 
 	Size int
+
+	// VerifyError is set by MultiWriteMemoryVerified if the written range read back different data
+	VerifyError error
 }
 
 type DeviceControl interface {
@@ -81,6 +137,63 @@ type DeviceControl interface {
 	PauseToggle(ctx context.Context) error
 }
 
+// SaveStateTarget selects where SaveState saves the emulator state to and LoadState loads it from.
+type SaveStateTarget struct {
+	// Slot is the numbered save state slot of the emulator, used unless Path or Transfer is set
+	Slot int
+	// Path is the path of a state file on the emulator's host
+	Path string
+	// Transfer makes SaveState return the state and LoadState load State instead of using a slot or file
+	Transfer bool
+	State    []byte
+}
+
+type DeviceSaveStates interface {
+	// SaveState saves the emulator state to target and returns it if target.Transfer is set. Drivers return an
+	// Unimplemented error for kinds of targets the emulator does not support.
+	SaveState(ctx context.Context, target SaveStateTarget) (state []byte, err error)
+	LoadState(ctx context.Context, target SaveStateTarget) error
+}
+
+type DeviceScreenshot interface {
+	// Screenshot captures the current screen. image is set if the device can transfer the image, path if the
+	// emulator saved it to a known file on its host or, if the file name is not known, the directory it saved it to.
+	Screenshot(ctx context.Context) (image []byte, mimeType string, path string, err error)
+}
+
+type DeviceEmulationControl interface {
+	// FrameAdvance advances emulation by frames and leaves it paused
+	FrameAdvance(ctx context.Context, frames int) error
+	// SetEmulationSpeed sets the emulation speed relative to normal, e.g. 2.0 to fast-forward or 0.5 for slow-motion
+	SetEmulationSpeed(ctx context.Context, speed float64) error
+}
+
+// EmulationStatus is the normalized state of an emulator or console
+type EmulationStatus struct {
+	State       sni.EmulationState
+	RomFileName string
+	CoreName    string
+}
+
+type DeviceEmulationState interface {
+	GetEmulationState(ctx context.Context) (EmulationStatus, error)
+}
+
+// BootRomSource is the ROM BootRom boots: either its contents or the path of a ROM file on SNI's host
+type BootRomSource struct {
+	// Rom is the contents of the ROM, used unless HostPath is set
+	Rom []byte
+	// HostPath is the path of a ROM file on SNI's host
+	HostPath string
+	// FileName names the ROM on the device; defaults to the base name of HostPath
+	FileName string
+}
+
+type DeviceBootRom interface {
+	// BootRom transfers the ROM to the device if it needs it, boots it and returns the path it booted on the device.
+	BootRom(ctx context.Context, source BootRomSource) (path string, err error)
+}
+
 type DeviceFilesystem interface {
 	ReadDirectory(ctx context.Context, path string) ([]DirEntry, error)
 	MakeDirectory(ctx context.Context, path string) error
@@ -103,6 +216,58 @@ type DeviceInfo interface {
 	FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error)
 }
 
+// DeviceASM is implemented by devices that can run 65816 code on the console
+type DeviceASM interface {
+	// ExecuteASM runs code once on the console with data available to it and returns the first resultSize bytes of the
+	// result buffer the code may write to.
+	ExecuteASM(ctx context.Context, code []byte, data []byte, resultSize int) (result []byte, err error)
+}
+
 type DeviceNWA interface {
 	NWACommand(ctx context.Context, cmd string, args string, binaryArg []byte) (asciiReply []map[string]string, binaryReply []byte, err error)
 }
+
+// NWAEmulatorInfo is the parsed reply to the emu-nwaccess EMULATOR_INFO command
+type NWAEmulatorInfo struct {
+	Name       string
+	Version    string
+	NWAVersion string
+	Id         string
+	Commands   []string
+}
+
+// NWACore is the parsed reply to the emu-nwaccess CORE_INFO command and an item of the CORES_LIST reply
+type NWACore struct {
+	Name     string
+	Platform string
+	Version  string
+	File     string
+}
+
+// NWACoreMemory is an item of the parsed reply to the emu-nwaccess CORE_MEMORIES command
+type NWACoreMemory struct {
+	Name   string
+	Access string
+	Size   uint32
+}
+
+// NWAGameInfo is the parsed reply to the emu-nwaccess GAME_INFO command
+type NWAGameInfo struct {
+	Name   string
+	File   string
+	Region string
+	Type   string
+}
+
+// DeviceNWAControl is implemented by emu-nwaccess emulators and sends common commands with parsed replies
+type DeviceNWAControl interface {
+	NWAEmulatorInfo(ctx context.Context) (info NWAEmulatorInfo, err error)
+	// NWACoresList lists the cores for platform or all cores if platform is empty
+	NWACoresList(ctx context.Context, platform string) (cores []NWACore, err error)
+	// NWACoreInfo describes the named core or the current core if name is empty
+	NWACoreInfo(ctx context.Context, name string) (core NWACore, err error)
+	NWALoadCore(ctx context.Context, name string) (err error)
+	NWACoreMemories(ctx context.Context) (memories []NWACoreMemory, err error)
+	NWALoadGame(ctx context.Context, path string) (err error)
+	NWAGameInfo(ctx context.Context) (info NWAGameInfo, err error)
+}
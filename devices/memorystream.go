@@ -0,0 +1,41 @@
+package devices
+
+import (
+	"context"
+	"time"
+)
+
+// PollReadMemory emulates a memory stream for devices without native streaming support by issuing a MultiReadMemory
+// request every interval and passing its responses to frame. It returns nil when ctx is canceled.
+func PollReadMemory(
+	ctx context.Context,
+	memory DeviceMemory,
+	interval time.Duration,
+	frame MemoryStreamFunc,
+	reads ...MemoryReadRequest,
+) (err error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		var rsp []MemoryReadResponse
+		rsp, err = memory.MultiReadMemory(ctx, reads...)
+		if err != nil {
+			if ctx.Err() != nil {
+				err = nil
+			}
+			return
+		}
+
+		err = frame(rsp)
+		if err != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
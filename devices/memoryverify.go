@@ -0,0 +1,80 @@
+package devices
+
+import (
+	"context"
+	"fmt"
+)
+
+// WriteMismatchError reports a written memory range that read back different data than was written.
+type WriteMismatchError struct {
+	Address AddressTuple
+	// Offset is the offset of the first mismatching byte from Address
+	Offset   int
+	Expected byte
+	Actual   byte
+}
+
+func (e *WriteMismatchError) Error() string {
+	return fmt.Sprintf(
+		"write to %s did not verify: offset $%x wrote $%02x but read back $%02x",
+		&e.Address,
+		e.Offset,
+		e.Expected,
+		e.Actual,
+	)
+}
+
+// MultiWriteMemoryVerified performs the writes and then reads every written range back with MultiReadMemory. The
+// VerifyError of each response whose range read back different data is set to a *WriteMismatchError; err is only set
+// if the writes or reads themselves failed.
+func MultiWriteMemoryVerified(
+	ctx context.Context,
+	memory DeviceMemory,
+	writes ...MemoryWriteRequest,
+) (wrsp []MemoryWriteResponse, err error) {
+	wrsp, err = memory.MultiWriteMemory(ctx, writes...)
+	if err != nil {
+		return
+	}
+
+	reads := make([]MemoryReadRequest, len(writes))
+	for i, write := range writes {
+		reads[i] = MemoryReadRequest{
+			RequestAddress: write.RequestAddress,
+			Size:           len(write.Data),
+		}
+	}
+
+	var rrsp []MemoryReadResponse
+	rrsp, err = memory.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		err = fmt.Errorf("could not read back writes to verify: %w", err)
+		return
+	}
+	if actual, expected := len(rrsp), len(writes); actual != expected {
+		err = fmt.Errorf("could not read back writes to verify; %d responses for %d writes", actual, expected)
+		return
+	}
+
+	for i, write := range writes {
+		if i >= len(wrsp) {
+			break
+		}
+		for j := range write.Data {
+			if j >= len(rrsp[i].Data) || rrsp[i].Data[j] != write.Data[j] {
+				mismatch := &WriteMismatchError{
+					Address:  write.RequestAddress,
+					Offset:   j,
+					Expected: write.Data[j],
+				}
+				if j < len(rrsp[i].Data) {
+					mismatch.Actual = rrsp[i].Data[j]
+				}
+				wrsp[i].VerifyError = mismatch
+				break
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,67 @@
+package devices
+
+import (
+	"context"
+	"errors"
+	"sni/protos/sni"
+	"testing"
+)
+
+// stuckMemory is a DeviceMemory whose byte at stuckAddress always reads back as 0:
+type stuckMemory struct {
+	mem          [0x100]byte
+	stuckAddress uint32
+}
+
+func (m *stuckMemory) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (bool, error) {
+	return false, nil
+}
+
+func (m *stuckMemory) RequiresMemoryMappingForAddress(ctx context.Context, address AddressTuple) (bool, error) {
+	return false, nil
+}
+
+func (m *stuckMemory) MultiReadMemory(ctx context.Context, reads ...MemoryReadRequest) (rsp []MemoryReadResponse, err error) {
+	rsp = make([]MemoryReadResponse, len(reads))
+	for i, read := range reads {
+		rsp[i] = MemoryReadResponse{RequestAddress: read.RequestAddress, DeviceAddress: read.RequestAddress}
+		rsp[i].Data = append([]byte(nil), m.mem[read.RequestAddress.Address:read.RequestAddress.Address+uint32(read.Size)]...)
+	}
+	return
+}
+
+func (m *stuckMemory) MultiWriteMemory(ctx context.Context, writes ...MemoryWriteRequest) (rsp []MemoryWriteResponse, err error) {
+	rsp = make([]MemoryWriteResponse, len(writes))
+	for i, write := range writes {
+		copy(m.mem[write.RequestAddress.Address:], write.Data)
+		m.mem[m.stuckAddress] = 0
+		rsp[i] = MemoryWriteResponse{RequestAddress: write.RequestAddress, DeviceAddress: write.RequestAddress, Size: len(write.Data)}
+	}
+	return
+}
+
+func TestMultiWriteMemoryVerified(t *testing.T) {
+	m := &stuckMemory{stuckAddress: 0x42}
+
+	rsp, err := MultiWriteMemoryVerified(
+		context.Background(),
+		m,
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x10}, Data: []byte{1, 2, 3}},
+		MemoryWriteRequest{RequestAddress: AddressTuple{Address: 0x40}, Data: []byte{4, 5, 6, 7}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp[0].VerifyError != nil {
+		t.Errorf("write[0] VerifyError = %v, want nil", rsp[0].VerifyError)
+	}
+
+	var mismatch *WriteMismatchError
+	if !errors.As(rsp[1].VerifyError, &mismatch) {
+		t.Fatalf("write[1] VerifyError = %v, want a *WriteMismatchError", rsp[1].VerifyError)
+	}
+	if mismatch.Offset != 2 || mismatch.Expected != 6 || mismatch.Actual != 0 {
+		t.Errorf("write[1] VerifyError = %#v", mismatch)
+	}
+}
@@ -0,0 +1,134 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"hash"
+	"io"
+	"path"
+)
+
+// PutFileOptions controls how PutFileWithOptions uploads a file.
+type PutFileOptions struct {
+	// Verify reads the file back from the device after uploading it and compares its SHA-256 hash with the hash of the
+	// uploaded data.
+	Verify bool
+	// Atomic uploads to a temporary file next to the target and only replaces the target with it once the upload (and
+	// verification, if requested) succeeded so that an interrupted transfer never leaves a truncated file behind.
+	Atomic bool
+}
+
+// TempUploadName returns the name of the temporary file in the same directory as filename that atomic uploads write to.
+func TempUploadName(filename string) string {
+	dir, base := path.Split(filename)
+	return dir + "." + base + ".part"
+}
+
+// BackupUploadName returns the name atomic uploads move an existing filename to until the upload replaced it.
+func BackupUploadName(filename string) string {
+	dir, base := path.Split(filename)
+	return dir + "." + base + ".bak"
+}
+
+// PutFileWithOptions uploads size bytes read from r to filename on the device and applies the options.
+// Atomic uploads rename the temporary file into place with RenameFile which only renames within a directory, so the
+// temporary file is created next to filename.
+func PutFileWithOptions(
+	ctx context.Context,
+	fs DeviceFilesystem,
+	filename string,
+	size uint32,
+	r io.Reader,
+	options PutFileOptions,
+	progress ProgressReportFunc,
+) (n uint32, err error) {
+	target := filename
+	if options.Atomic {
+		target = TempUploadName(filename)
+	}
+
+	var h hash.Hash
+	if options.Verify {
+		h = sha256.New()
+		r = io.TeeReader(r, h)
+	}
+
+	n, err = fs.PutFile(ctx, target, size, r, progress)
+	if err != nil {
+		return
+	}
+
+	if options.Verify {
+		err = VerifyFile(ctx, fs, target, n, h.Sum(nil))
+		if err != nil {
+			if options.Atomic {
+				_ = fs.RemoveFile(ctx, target)
+			}
+			return
+		}
+	}
+
+	if options.Atomic {
+		err = replaceFile(ctx, fs, target, filename)
+	}
+
+	return
+}
+
+// replaceFile renames temp to filename. The rename fails if filename exists so an existing file is first moved aside
+// to its backup name and moved back if the rename fails, leaving either the old or the new file in place.
+func replaceFile(ctx context.Context, fs DeviceFilesystem, temp string, filename string) (err error) {
+	backup := BackupUploadName(filename)
+
+	// a backup left behind by an earlier interrupted upload is stale; a failure to rename filename to it means
+	// filename does not exist yet or is reported by the rename of temp:
+	_ = fs.RemoveFile(ctx, backup)
+	backedUp := fs.RenameFile(ctx, filename, path.Base(backup)) == nil
+
+	err = fs.RenameFile(ctx, temp, path.Base(filename))
+	if err != nil {
+		err = fmt.Errorf("could not rename '%s' to '%s': %w", temp, filename, err)
+		if backedUp {
+			if rerr := fs.RenameFile(ctx, backup, path.Base(filename)); rerr != nil {
+				err = fmt.Errorf("%w; could not restore '%s' from '%s': %v", err, filename, backup, rerr)
+			}
+		}
+		return
+	}
+
+	if backedUp {
+		_ = fs.RemoveFile(ctx, backup)
+	}
+	return
+}
+
+// VerifyFile reads filename back from the device and checks that it is size bytes long and its SHA-256 hash matches
+// sum.
+func VerifyFile(ctx context.Context, fs DeviceFilesystem, filename string, size uint32, sum []byte) (err error) {
+	h := sha256.New()
+	var n uint32
+	n, err = fs.GetFile(ctx, filename, h, nil, nil)
+	if err != nil {
+		return
+	}
+
+	if n != size {
+		err = WithCode(
+			codes.DataLoss,
+			fmt.Errorf("verify '%s': device file is %d bytes; expected %d", filename, n, size),
+		)
+		return
+	}
+	if !bytes.Equal(h.Sum(nil), sum) {
+		err = WithCode(
+			codes.DataLoss,
+			fmt.Errorf("verify '%s': device file contents do not match uploaded data", filename),
+		)
+		return
+	}
+
+	return
+}
@@ -0,0 +1,92 @@
+package devices
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"testing"
+)
+
+// memFilesystem keeps files in a map; RenameFile fails if the new name exists or for names in failRenames:
+type memFilesystem struct {
+	files       map[string][]byte
+	failRenames map[string]bool
+}
+
+func (m *memFilesystem) ReadDirectory(ctx context.Context, path string) ([]DirEntry, error) {
+	return nil, nil
+}
+
+func (m *memFilesystem) MakeDirectory(ctx context.Context, path string) error { return nil }
+
+func (m *memFilesystem) RemoveFile(ctx context.Context, name string) error {
+	if _, ok := m.files[name]; !ok {
+		return fmt.Errorf("'%s' does not exist", name)
+	}
+	delete(m.files, name)
+	return nil
+}
+
+func (m *memFilesystem) RenameFile(ctx context.Context, name, newFilename string) error {
+	newName := path.Join(path.Dir(name), newFilename)
+	data, ok := m.files[name]
+	if !ok {
+		return fmt.Errorf("'%s' does not exist", name)
+	}
+	if _, exists := m.files[newName]; exists || m.failRenames[name] {
+		return fmt.Errorf("could not rename '%s'", name)
+	}
+	delete(m.files, name)
+	m.files[newName] = data
+	return nil
+}
+
+func (m *memFilesystem) PutFile(ctx context.Context, name string, size uint32, r io.Reader, progress ProgressReportFunc) (n uint32, err error) {
+	var data []byte
+	data, err = io.ReadAll(io.LimitReader(r, int64(size)))
+	m.files[name] = data
+	return uint32(len(data)), err
+}
+
+func (m *memFilesystem) GetFile(ctx context.Context, name string, w io.Writer, sizeReceived SizeReceivedFunc, progress ProgressReportFunc) (size uint32, err error) {
+	var n int
+	n, err = w.Write(m.files[name])
+	return uint32(n), err
+}
+
+func (m *memFilesystem) BootFile(ctx context.Context, path string) error { return nil }
+
+func TestPutFileWithOptions_Atomic(t *testing.T) {
+	ctx := context.Background()
+	options := PutFileOptions{Verify: true, Atomic: true}
+	data := []byte("new contents")
+
+	fs := &memFilesystem{files: map[string][]byte{"/roms/test.sfc": []byte("old contents")}}
+	if _, err := PutFileWithOptions(ctx, fs, "/roms/test.sfc", uint32(len(data)), bytes.NewReader(data), options, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := fs.files["/roms/test.sfc"]; !bytes.Equal(got, data) {
+		t.Errorf("file = %q, want %q", got, data)
+	}
+	if len(fs.files) != 1 {
+		t.Errorf("files = %v, want only the uploaded file", fs.files)
+	}
+
+	// the original file stays in place if the uploaded file cannot be renamed to it:
+	old := []byte("old contents")
+	fs = &memFilesystem{
+		files:       map[string][]byte{"/roms/test.sfc": old},
+		failRenames: map[string]bool{TempUploadName("/roms/test.sfc"): true},
+	}
+	if _, err := PutFileWithOptions(ctx, fs, "/roms/test.sfc", uint32(len(data)), bytes.NewReader(data), options, nil); err == nil {
+		t.Fatal("expected PutFileWithOptions() to fail")
+	}
+	if got := fs.files["/roms/test.sfc"]; !bytes.Equal(got, old) {
+		t.Errorf("file after a failed rename = %q, want %q", got, old)
+	}
+	if _, ok := fs.files[BackupUploadName("/roms/test.sfc")]; ok {
+		t.Error("PutFileWithOptions() left the backup behind")
+	}
+}
@@ -7,9 +7,11 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"io"
 	"log"
 	"net"
+	"os"
 	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/devices/snes/mapping"
@@ -178,8 +180,8 @@ func (c *Client) SendCommandWaitReply(cmd string, deadline time.Time) (bin []byt
 		return
 	}
 	if ascii != nil && len(ascii) > 0 {
-		if errText, ok := ascii[0]["error"]; ok {
-			err = fmt.Errorf("emunwa: error=%s", errText)
+		if _, ok := ascii[0]["error"]; ok {
+			err = replyError(ascii[0])
 			return
 		}
 	}
@@ -211,14 +213,32 @@ func (c *Client) SendCommandBinaryWaitReply(cmd string, binaryArg []byte, deadli
 
 	bin, ascii, err = c.readResponse(deadline)
 	if ascii != nil && len(ascii) > 0 {
-		if errText, ok := ascii[0]["error"]; ok {
-			err = fmt.Errorf("emunwa: error=%s", errText)
+		if _, ok := ascii[0]["error"]; ok {
+			err = replyError(ascii[0])
 			return
 		}
 	}
 	return
 }
 
+// replyError converts an `error:` reply to an error with the gRPC code that matches the NWA error kind:
+func replyError(item map[string]string) error {
+	code := codes.Unknown
+	switch item["error"] {
+	case "invalid_command":
+		code = codes.Unimplemented
+	case "invalid_argument":
+		code = codes.InvalidArgument
+	case "not_allowed":
+		code = codes.FailedPrecondition
+	case "protocol_error":
+		code = codes.Internal
+	case "command_error":
+		code = codes.Aborted
+	}
+	return devices.WithCode(code, fmt.Errorf("emunwa: error=%s reason=%s", item["error"], item["reason"]))
+}
+
 func (c *Client) readResponse(deadline time.Time) (bin []byte, ascii []map[string]string, err error) {
 	err = c.c.SetReadDeadline(deadline)
 	if err != nil {
@@ -266,23 +286,29 @@ func (c *Client) parseResponse(r *bufio.Reader) (bin []byte, ascii []map[string]
 		return
 	}
 
-	// parse ascii reply as array<map<string,string>>:
+	// parse ascii reply as array<map<string,string>>; read line by line instead of with a bufio.Scanner which would
+	// buffer the replies that follow:
 	var b strings.Builder
-	var sr io.Reader
-	if config.VerboseLogging {
-		// copy all bytes read to a string builder so we can log it after all scanned data:
-		sr = io.TeeReader(r, &b)
-	} else {
-		sr = r
-	}
-
-	var s *bufio.Scanner
-	s = bufio.NewScanner(sr)
-
 	ascii = make([]map[string]string, 0, 4)
 	item := make(map[string]string)
-	for s.Scan() {
-		l := s.Text()
+	for {
+		var l string
+		l, err = r.ReadString('\n')
+		if config.VerboseLogging {
+			// copy all bytes read to a string builder so we can log it after all scanned data:
+			b.WriteString(l)
+		}
+		if err == io.EOF && l != "" {
+			// the last line of the reply:
+			err = nil
+		} else if err == io.EOF {
+			err = nil
+			break
+		} else if err != nil {
+			err = c.FatalError(err)
+			return
+		}
+		l = strings.TrimSuffix(l, "\n")
 		// empty line:
 		if l == "" {
 			break
@@ -342,6 +368,29 @@ func (c *Client) RequiresMemoryMappingForAddress(ctx context.Context, address de
 	return true, nil
 }
 
+func (c *Client) ListMemoryRegions(ctx context.Context) (regions []devices.MemoryRegion, err error) {
+	var memories []devices.NWACoreMemory
+	memories, err = c.NWACoreMemories(ctx)
+	if err != nil {
+		return
+	}
+
+	// only the core memories named after memory types can be reached through the FX Pak Pro address space:
+	for _, memory := range memories {
+		region, ok := mapping.MemoryRegionFor(
+			mapping.MemoryType(memory.Name),
+			memory.Size,
+			strings.Contains(memory.Access, "r"),
+			strings.Contains(memory.Access, "w"),
+		)
+		if !ok {
+			continue
+		}
+		regions = append(regions, region)
+	}
+	return
+}
+
 func (c *Client) MultiReadMemory(ctx context.Context, reads ...devices.MemoryReadRequest) (mrsp []devices.MemoryReadResponse, err error) {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -692,3 +741,124 @@ func (c *Client) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 
 	return
 }
+
+// GetEmulationState combines EMULATION_STATUS, GAME_INFO and CORE_CURRENT_INFO into the normalized emulation state.
+func (c *Client) GetEmulationState(ctx context.Context) (status devices.EmulationStatus, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var emulationStatus []map[string]string
+	_, emulationStatus, err = c.SendCommandWaitReply("EMULATION_STATUS", deadline)
+	if err != nil {
+		return
+	}
+
+	switch getFirstValue(emulationStatus, "state") {
+	case "running":
+		status.State = sni.EmulationState_Running
+	case "paused":
+		status.State = sni.EmulationState_Paused
+	case "no_game", "stopped":
+		status.State = sni.EmulationState_NoContent
+		return
+	default:
+		status.State = sni.EmulationState_StateUnknown
+	}
+
+	var gameInfo []map[string]string
+	_, gameInfo, err = c.SendCommandWaitReply("GAME_INFO", deadline)
+	if err != nil {
+		return
+	}
+	status.RomFileName = getFirstValue(gameInfo, "file")
+
+	var coreInfo []map[string]string
+	_, coreInfo, err = c.SendCommandWaitReply("CORE_CURRENT_INFO", deadline)
+	if err != nil {
+		return
+	}
+	status.CoreName = getFirstValue(coreInfo, "name")
+
+	return
+}
+
+// SaveState saves to a state file with the SAVE_STATE command. NWA has no save state slots; states are transferred
+// through a temporary file which requires the emulator to run on this host.
+func (c *Client) SaveState(ctx context.Context, target devices.SaveStateTarget) (state []byte, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	path := target.Path
+	if target.Transfer {
+		path, err = c.tempStatePath()
+		if err != nil {
+			return
+		}
+		defer os.Remove(path)
+	} else if path == "" {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("emunwa: save state slots are not supported; use a path"))
+		return
+	}
+
+	_, _, err = c.SendCommandWaitReply("SAVE_STATE "+path, deadline)
+	if err != nil {
+		return
+	}
+
+	if target.Transfer {
+		state, err = os.ReadFile(path)
+	}
+	return
+}
+
+// LoadState loads from a state file with the LOAD_STATE command.
+func (c *Client) LoadState(ctx context.Context, target devices.SaveStateTarget) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	path := target.Path
+	if target.Transfer {
+		path, err = c.tempStatePath()
+		if err != nil {
+			return
+		}
+		defer os.Remove(path)
+
+		err = os.WriteFile(path, target.State, 0644)
+		if err != nil {
+			return
+		}
+	} else if path == "" {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("emunwa: save state slots are not supported; use a path"))
+		return
+	}
+
+	_, _, err = c.SendCommandWaitReply("LOAD_STATE "+path, deadline)
+	return
+}
+
+// tempStatePath returns the path of a new temporary file the emulator can exchange a state through:
+func (c *Client) tempStatePath() (path string, err error) {
+	if !c.addr.IP.IsLoopback() {
+		err = devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("emunwa: transferring states requires the emulator to run on this host"),
+		)
+		return
+	}
+
+	var f *os.File
+	f, err = os.CreateTemp("", "sni-*.state")
+	if err != nil {
+		return
+	}
+	path = f.Name()
+	err = f.Close()
+	return
+}
@@ -3,8 +3,17 @@ package emunwa
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"net"
+	"os"
 	"reflect"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
 	"testing"
+	"time"
 )
 
 func Test_parseResponse(t *testing.T) {
@@ -89,3 +98,172 @@ func Test_parseResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_SaveStateTransfer(t *testing.T) {
+	state := []byte("state data")
+	var loaded []byte
+	c := connectFakeNWA(t, func(cmd string) string {
+		switch {
+		case strings.HasPrefix(cmd, "SAVE_STATE "):
+			if err := os.WriteFile(strings.TrimPrefix(cmd, "SAVE_STATE "), state, 0644); err != nil {
+				return "\nerror:" + err.Error() + "\n\n"
+			}
+		case strings.HasPrefix(cmd, "LOAD_STATE "):
+			loaded, _ = os.ReadFile(strings.TrimPrefix(cmd, "LOAD_STATE "))
+		default:
+			return "\nerror:unknown command\n\n"
+		}
+		return "\nok:\n\n"
+	})
+
+	ctx := context.Background()
+	got, err := c.SaveState(ctx, devices.SaveStateTarget{Transfer: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, state) {
+		t.Fatalf("SaveState() = %q, want %q", got, state)
+	}
+
+	if err = c.LoadState(ctx, devices.SaveStateTarget{Transfer: true, State: got}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(loaded, state) {
+		t.Fatalf("LoadState() loaded %q, want %q", loaded, state)
+	}
+
+	if _, err = c.SaveState(ctx, devices.SaveStateTarget{Slot: 1}); err == nil {
+		t.Fatal("expected SaveState() to a slot to fail")
+	}
+}
+
+func TestClient_GetEmulationState(t *testing.T) {
+	state := "paused"
+	c := connectFakeNWA(t, func(cmd string) string {
+		switch cmd {
+		case "EMULATION_STATUS":
+			return "\nstate:" + state + "\ngame:lttp\n\n"
+		case "GAME_INFO":
+			return "\nname:lttp\nfile:/roms/lttp.sfc\n\n"
+		case "CORE_CURRENT_INFO":
+			return "\nname:bsnes\nplatform:SNES\n\n"
+		}
+		return "\nerror:unknown command\n\n"
+	})
+
+	ctx := context.Background()
+	got, err := c.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := devices.EmulationStatus{State: sni.EmulationState_Paused, RomFileName: "/roms/lttp.sfc", CoreName: "bsnes"}
+	if got != want {
+		t.Fatalf("GetEmulationState() = %+v, want %+v", got, want)
+	}
+
+	state = "no_game"
+	got, err = c.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != sni.EmulationState_NoContent {
+		t.Fatalf("GetEmulationState().State = %v, want %v", got.State, sni.EmulationState_NoContent)
+	}
+}
+
+func TestClient_NWAControl(t *testing.T) {
+	c := connectFakeNWA(t, func(cmd string) string {
+		switch cmd {
+		case "EMULATOR_INFO":
+			return "\nname:bsnes-plus\nversion:0.0.1\nnwa_version:1.0\nid:1\ncommands:EMULATOR_INFO,CORES_LIST,CORE_MEMORIES\n\n"
+		case "CORES_LIST SNES":
+			return "\nname:bsnes\nplatform:SNES\nname:snes9x\nplatform:SNES\n\n"
+		case "CORE_MEMORIES":
+			return "\nname:WRAM\naccess:rw\nsize:131072\nname:CARTROM\naccess:r\nsize:$400000\n\n"
+		case "LOAD_GAME /roms/missing.sfc":
+			return "\nerror:command_error\nreason:file not found\n\n"
+		}
+		return "\nerror:invalid_command\nreason:unknown command\n\n"
+	})
+
+	ctx := context.Background()
+	info, err := c.NWAEmulatorInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantInfo := devices.NWAEmulatorInfo{
+		Name:       "bsnes-plus",
+		Version:    "0.0.1",
+		NWAVersion: "1.0",
+		Id:         "1",
+		Commands:   []string{"EMULATOR_INFO", "CORES_LIST", "CORE_MEMORIES"},
+	}
+	if !reflect.DeepEqual(info, wantInfo) {
+		t.Fatalf("NWAEmulatorInfo() = %+v, want %+v", info, wantInfo)
+	}
+
+	cores, err := c.NWACoresList(ctx, "SNES")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantCores := []devices.NWACore{{Name: "bsnes", Platform: "SNES"}, {Name: "snes9x", Platform: "SNES"}}
+	if !reflect.DeepEqual(cores, wantCores) {
+		t.Fatalf("NWACoresList() = %+v, want %+v", cores, wantCores)
+	}
+
+	memories, err := c.NWACoreMemories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantMemories := []devices.NWACoreMemory{{Name: "WRAM", Access: "rw", Size: 0x20000}, {Name: "CARTROM", Access: "r", Size: 0x400000}}
+	if !reflect.DeepEqual(memories, wantMemories) {
+		t.Fatalf("NWACoreMemories() = %+v, want %+v", memories, wantMemories)
+	}
+
+	var coded *devices.CodedError
+	err = c.NWALoadGame(ctx, "/roms/missing.sfc")
+	if !errors.As(err, &coded) || coded.Code != codes.Aborted {
+		t.Fatalf("NWALoadGame() error = %v, want code %v", err, codes.Aborted)
+	}
+	_, err = c.NWACoreInfo(ctx, "missing")
+	if !errors.As(err, &coded) || coded.Code != codes.Unimplemented {
+		t.Fatalf("NWACoreInfo() error = %v, want code %v", err, codes.Unimplemented)
+	}
+}
+
+// connectFakeNWA starts an NWA server on the loopback interface that replies to every command line with the reply
+// returned by handle and connects a Client to it.
+func connectFakeNWA(t *testing.T, handle func(cmd string) string) *Client {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if _, err = conn.Write([]byte(handle(strings.TrimSuffix(line, "\n")))); err != nil {
+				return
+			}
+		}
+	}()
+
+	c := NewClient(l.Addr().(*net.TCPAddr), "fake", time.Second)
+	if err = c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+
+	return c
+}
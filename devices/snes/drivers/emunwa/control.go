@@ -0,0 +1,191 @@
+package emunwa
+
+import (
+	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// commandLine joins a command and its optional argument:
+func commandLine(cmd string, arg string) string {
+	if arg == "" {
+		return cmd
+	}
+	return cmd + " " + arg
+}
+
+func parseCore(item map[string]string) devices.NWACore {
+	return devices.NWACore{
+		Name:     item["name"],
+		Platform: item["platform"],
+		Version:  item["version"],
+		File:     item["file"],
+	}
+}
+
+func (c *Client) NWAEmulatorInfo(ctx context.Context) (info devices.NWAEmulatorInfo, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var reply []map[string]string
+	_, reply, err = c.SendCommandWaitReply("EMULATOR_INFO", deadline)
+	if err != nil {
+		return
+	}
+
+	info = devices.NWAEmulatorInfo{
+		Name:       getFirstValue(reply, "name"),
+		Version:    getFirstValue(reply, "version"),
+		NWAVersion: getFirstValue(reply, "nwa_version"),
+		Id:         getFirstValue(reply, "id"),
+	}
+	for _, command := range strings.Split(getFirstValue(reply, "commands"), ",") {
+		if command = strings.TrimSpace(command); command != "" {
+			info.Commands = append(info.Commands, command)
+		}
+	}
+	return
+}
+
+func (c *Client) NWACoresList(ctx context.Context, platform string) (cores []devices.NWACore, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var reply []map[string]string
+	_, reply, err = c.SendCommandWaitReply(commandLine("CORES_LIST", platform), deadline)
+	if err != nil {
+		return
+	}
+
+	cores = make([]devices.NWACore, 0, len(reply))
+	for _, item := range reply {
+		cores = append(cores, parseCore(item))
+	}
+	return
+}
+
+func (c *Client) NWACoreInfo(ctx context.Context, name string) (core devices.NWACore, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	cmd := "CORE_CURRENT_INFO"
+	if name != "" {
+		cmd = "CORE_INFO " + name
+	}
+
+	var reply []map[string]string
+	_, reply, err = c.SendCommandWaitReply(cmd, deadline)
+	if err != nil {
+		return
+	}
+	if len(reply) > 0 {
+		core = parseCore(reply[0])
+	}
+	return
+}
+
+func (c *Client) NWALoadCore(ctx context.Context, name string) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	_, _, err = c.SendCommandWaitReply(commandLine("LOAD_CORE", name), deadline)
+	return
+}
+
+func (c *Client) NWACoreMemories(ctx context.Context) (memories []devices.NWACoreMemory, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var reply []map[string]string
+	_, reply, err = c.SendCommandWaitReply("CORE_MEMORIES", deadline)
+	if err != nil {
+		return
+	}
+
+	memories = make([]devices.NWACoreMemory, 0, len(reply))
+	for _, item := range reply {
+		var size uint64
+		// sizes are decimal or '$' prefixed hex:
+		s := item["size"]
+		if strings.HasPrefix(s, "$") {
+			size, err = strconv.ParseUint(s[1:], 16, 32)
+		} else {
+			size, err = strconv.ParseUint(s, 0, 32)
+		}
+		if err != nil {
+			err = c.NonFatalError(fmt.Errorf("emunwa: CORE_MEMORIES: memory '%s' has invalid size '%s'", item["name"], s))
+			return
+		}
+
+		memories = append(memories, devices.NWACoreMemory{
+			Name:   item["name"],
+			Access: item["access"],
+			Size:   uint32(size),
+		})
+	}
+	return
+}
+
+func (c *Client) NWALoadGame(ctx context.Context, path string) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	_, _, err = c.SendCommandWaitReply(commandLine("LOAD_GAME", path), deadline)
+	return
+}
+
+func (c *Client) NWAGameInfo(ctx context.Context) (info devices.NWAGameInfo, err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	var reply []map[string]string
+	_, reply, err = c.SendCommandWaitReply("GAME_INFO", deadline)
+	if err != nil {
+		return
+	}
+
+	info = devices.NWAGameInfo{
+		Name:   getFirstValue(reply, "name"),
+		File:   getFirstValue(reply, "file"),
+		Region: getFirstValue(reply, "region"),
+		Type:   getFirstValue(reply, "type"),
+	}
+	return
+}
+
+// BootRom loads the ROM with LOAD_GAME from a path on this host which requires the emulator to run on this host.
+func (c *Client) BootRom(ctx context.Context, source devices.BootRomSource) (path string, err error) {
+	if !c.addr.IP.IsLoopback() {
+		err = devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("emunwa: booting ROMs requires the emulator to run on this host"),
+		)
+		return
+	}
+
+	path, err = source.LocalPath()
+	if err != nil {
+		return
+	}
+
+	err = c.NWALoadGame(ctx, path)
+	return
+}
@@ -3,10 +3,14 @@ package emunwa
 import (
 	"encoding/hex"
 	"fmt"
+	"github.com/alttpo/observable"
 	"github.com/alttpo/snes/timing"
+	"github.com/spf13/viper"
 	"log"
 	"net"
 	"net/url"
+	"os"
+	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
 	"sni/util"
@@ -24,25 +28,87 @@ var driver *Driver
 
 const defaultAddressSpace = sni.AddressSpace_SnesABus
 
+// hostsConfigKey is the config.yaml key of the endpoints to detect EmuNWA emulators at; see devices.ParseEndpoints
+const hostsConfigKey = "emunwaHosts"
+
 type Driver struct {
 	container devices.DeviceContainer
 
-	detectors []*Client
+	detectorsLock sync.Mutex
+	detectors     []*Client
+	// display names of detector endpoints keyed by resolved address:
+	names map[string]string
 }
 
-func NewDriver(addresses []*net.TCPAddr) *Driver {
-	d := &Driver{
-		detectors: make([]*Client, len(addresses)),
-	}
+func NewDriver(endpoints []devices.Endpoint) *Driver {
+	d := &Driver{}
 	d.container = devices.NewDeviceDriverContainer(d.openDevice)
+	d.SetEndpoints(endpoints)
+
+	return d
+}
+
+// SetEndpoints replaces the endpoints to detect EmuNWA emulators at; detectors of endpoints that remain are kept.
+func (d *Driver) SetEndpoints(endpoints []devices.Endpoint) {
+	// resolve the addresses:
+	addresses := make([]*net.TCPAddr, 0, len(endpoints))
+	names := make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		addr, err := net.ResolveTCPAddr("tcp", endpoint.Address)
+		if err != nil {
+			log.Printf("emunwa: resolve('%s'): %v\n", endpoint.Address, err)
+			// drop the address if it doesn't resolve; it is resolved again when the config changes:
+			continue
+		}
+		if _, ok := names[addr.String()]; ok {
+			continue
+		}
+
+		addresses = append(addresses, addr)
+		names[addr.String()] = endpoint.Name
+	}
+
+	defer d.detectorsLock.Unlock()
+	d.detectorsLock.Lock()
+
+	existing := make(map[string]*Client, len(d.detectors))
+	for _, detector := range d.detectors {
+		existing[detector.addr.String()] = detector
+	}
 
+	detectors := make([]*Client, len(addresses))
 	for i, addr := range addresses {
+		if detector, ok := existing[addr.String()]; ok {
+			detectors[i] = detector
+			delete(existing, addr.String())
+			continue
+		}
 		c := NewClient(addr, addr.String(), timing.Frame*4)
 		c.MuteLog(!logDetector)
-		d.detectors[i] = c
+		detectors[i] = c
 	}
 
-	return d
+	// close detectors of removed endpoints:
+	for _, detector := range existing {
+		_ = detector.Close()
+	}
+
+	d.detectors = detectors
+	d.names = names
+}
+
+// replaceDetector swaps in a fresh detector for a closed one unless its endpoint was removed meanwhile:
+func (d *Driver) replaceDetector(old, c *Client) bool {
+	defer d.detectorsLock.Unlock()
+	d.detectorsLock.Lock()
+
+	for i := range d.detectors {
+		if d.detectors[i] == old {
+			d.detectors[i] = c
+			return true
+		}
+	}
+	return false
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -67,6 +133,10 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_PauseUnpauseEmulation,
 	sni.DeviceCapability_FetchFields,
 	sni.DeviceCapability_NWACommand,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_GetEmulationState,
+	sni.DeviceCapability_BootRom,
+	sni.DeviceCapability_ListMemoryRegions,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -93,12 +163,17 @@ func (d *Driver) openDevice(uri *url.URL) (q devices.Device, err error) {
 }
 
 func (d *Driver) Detect() (devs []devices.DeviceDescriptor, derr error) {
+	d.detectorsLock.Lock()
+	detectors := append([]*Client(nil), d.detectors...)
+	names := d.names
+	d.detectorsLock.Unlock()
+
 	devicesLock := sync.Mutex{}
-	devs = make([]devices.DeviceDescriptor, 0, len(d.detectors))
+	devs = make([]devices.DeviceDescriptor, 0, len(detectors))
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(d.detectors))
-	for i, de := range d.detectors {
+	wg.Add(len(detectors))
+	for i, de := range detectors {
 		// run detectors in parallel:
 		go func(i int, detector *Client) {
 			defer util.Recover()
@@ -116,7 +191,10 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, derr error) {
 				// refresh detector:
 				c := NewClient(detector.addr, fmt.Sprintf("emunwa[%d]", i), timing.Frame*4)
 				c.MuteLog(!logDetector)
-				d.detectors[i] = c
+				if !d.replaceDetector(detector, c) {
+					// endpoint was removed:
+					return
+				}
 				detector = c
 			}
 
@@ -131,7 +209,7 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, derr error) {
 				}
 
 				// detect accidental loopback connections:
-				if detector.DetectLoopback(d.detectors) {
+				if detector.DetectLoopback(detectors) {
 					if logDetector {
 						log.Printf("emunwa: detect: detector[%d]: loopback connection detected; breaking\n", i)
 					}
@@ -175,9 +253,26 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, derr error) {
 				version = status[0]["version"]
 			}
 
+			// SNI's own NWA server proxies devices SNI already lists:
+			if name == "SNI" {
+				if logDetector {
+					log.Printf("emunwa: detect: detector[%d]: skipping SNI's own NWA server\n", i)
+				}
+				err = detector.Close()
+				if err != nil {
+					log.Printf("emunwa: detect: detector[%d]: error closing detector: %v\n", i, err)
+				}
+				return
+			}
+
+			displayName := fmt.Sprintf("%s %s (emunwa)", name, version)
+			if endpointName := names[detector.addr.String()]; endpointName != "" {
+				displayName = fmt.Sprintf("%s - %s", endpointName, displayName)
+			}
+
 			descriptor := devices.DeviceDescriptor{
 				Uri:                 url.URL{Scheme: driverName, Host: detector.addr.String()},
-				DisplayName:         fmt.Sprintf("%s %s (emunwa)", name, version),
+				DisplayName:         displayName,
 				Kind:                d.Kind(),
 				Capabilities:        driverCapabilities[:],
 				DefaultAddressSpace: defaultAddressSpace,
@@ -230,41 +325,27 @@ func DriverInit() {
 
 	disableOldRange := util.IsTruthy(env.GetOrDefault("NWA_DISABLE_OLD_RANGE", "0"))
 
-	// comma-delimited list of host:port pairs:
-	hostsStr := env.GetOrSupply("SNI_EMUNW_HOSTS", func() string {
-		const count = 10
-		hosts := make([]string, 0, 20)
-		if disableOldRange {
-			log.Printf("emunwa: disabling old port range 65400..65409 due to NWA_DISABLE_OLD_RANGE")
-		}
-		if disableOldRange || (basePort != 65400) {
-			for i := uint64(0); i < count; i++ {
-				hosts = append(hosts, fmt.Sprintf("localhost:%d", basePort+i))
-			}
-		}
-		if !disableOldRange {
-			for i := 0; i < count; i++ {
-				hosts = append(hosts, fmt.Sprintf("localhost:%d", 65400+i))
-			}
-		}
-		return strings.Join(hosts, ",")
-	})
-
-	// split the hostsStr list by commas:
-	hosts := strings.Split(hostsStr, ",")
-
-	// resolve the addresses:
-	addresses := make([]*net.TCPAddr, 0, len(hosts))
-	for _, host := range hosts {
-		addr, err := net.ResolveTCPAddr("tcp", host)
-		if err != nil {
-			log.Printf("emunwa: resolve('%s'): %v\n", host, err)
-			// drop the address if it doesn't resolve:
-			// TODO: consider retrying the resolve later? maybe not worth worrying about.
-			continue
+	// default port ranges if config.yaml does not list any endpoints:
+	hosts := make([]string, 0, 2)
+	if disableOldRange {
+		log.Printf("emunwa: disabling old port range 65400..65409 due to NWA_DISABLE_OLD_RANGE")
+	}
+	if disableOldRange || (basePort != 65400) {
+		lastPort := basePort + 9
+		if lastPort > 0xffff {
+			lastPort = 0xffff
 		}
+		hosts = append(hosts, fmt.Sprintf("localhost:%d-%d", basePort, lastPort))
+	}
+	if !disableOldRange {
+		hosts = append(hosts, "localhost:65400-65409")
+	}
+	defaultHosts = strings.Join(hosts, ",")
 
-		addresses = append(addresses, addr)
+	// comma-delimited list of host:port pairs; overrides the config.yaml list:
+	envHosts = os.Getenv("SNI_EMUNW_HOSTS")
+	if envHosts != "" {
+		log.Printf("emunwa: using SNI_EMUNW_HOSTS='%s' instead of %s from config.yaml\n", envHosts, hostsConfigKey)
 	}
 
 	if util.IsTruthy(env.GetOrDefault("SNI_EMUNW_DETECT_LOG", "0")) {
@@ -273,6 +354,51 @@ func DriverInit() {
 	}
 
 	// register the driver:
-	driver = NewDriver(addresses)
+	endpoints, err := configuredEndpoints(config.Config)
+	if err != nil {
+		log.Printf("emunwa: %v\n", err)
+	}
+	driver = NewDriver(endpoints)
 	devices.Register(driverName, driver)
+
+	// add and remove detectors as config.yaml changes:
+	if config.ConfigObservable != nil {
+		config.ConfigObservable.Subscribe(observable.NewObserver(driverName, func(event observable.Event) {
+			v, ok := event.Value.(*viper.Viper)
+			if !ok || v == nil {
+				return
+			}
+
+			endpoints, err := configuredEndpoints(v)
+			if err != nil {
+				// keep detecting at the previous endpoints until the config is fixed:
+				log.Printf("emunwa: %v\n", err)
+				return
+			}
+			driver.SetEndpoints(endpoints)
+		}))
+	}
+}
+
+var (
+	// envHosts is the value of SNI_EMUNW_HOSTS which overrides config.yaml if set:
+	envHosts string
+	// defaultHosts are the port ranges derived from NWA_PORT_RANGE and NWA_DISABLE_OLD_RANGE:
+	defaultHosts string
+)
+
+// configuredEndpoints returns the endpoints from SNI_EMUNW_HOSTS, else from config.yaml, else the default port ranges
+// on localhost.
+func configuredEndpoints(v *viper.Viper) (endpoints []devices.Endpoint, err error) {
+	var value interface{}
+	if envHosts != "" {
+		value = envHosts
+	} else if v.IsSet(hostsConfigKey) {
+		value = v.Get(hostsConfigKey)
+	} else {
+		value = defaultHosts
+	}
+
+	endpoints, err = devices.ParseEndpoints(value)
+	return
 }
@@ -0,0 +1,189 @@
+package fxpakpro
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/spf13/cast"
+	"go.bug.st/serial"
+	"google.golang.org/grpc/codes"
+	"log"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"strings"
+	"time"
+)
+
+// baudRatesConfigKey is the config.yaml key of the list of `device` and `baud` pairs of a DeviceKey and the best baud
+// rate found for it by BenchmarkBaudRates. It is not a map keyed by DeviceKey since viper splits map keys at '.' which
+// identity keys like `id/usb-1-1.2` contain.
+const baudRatesConfigKey = "fxpakproBaudRates"
+
+// benchmarkAddress is the start of ROM which does not change while the benchmark reads it repeatedly:
+const (
+	benchmarkAddress = 0x000000
+	benchmarkGetSize = 0x2000
+)
+
+const defaultBenchmarkDuration = time.Second * 2
+
+// rememberedBaudRate returns the baud rate BenchmarkBaudRates found best for the device, or 0 if there is none.
+func rememberedBaudRate(deviceKey string) int {
+	deviceKey = strings.ToLower(deviceKey)
+	for _, entry := range cast.ToSlice(config.Config.Get(baudRatesConfigKey)) {
+		rate := cast.ToStringMap(entry)
+		if cast.ToString(rate["device"]) == deviceKey {
+			return cast.ToInt(rate["baud"])
+		}
+	}
+	return 0
+}
+
+func (d *Driver) RememberBaudRate(uri *url.URL, baud int) {
+	deviceKey := strings.ToLower(d.DeviceKey(uri))
+
+	rates := []interface{}{map[string]interface{}{"device": deviceKey, "baud": baud}}
+	for _, entry := range cast.ToSlice(config.Config.Get(baudRatesConfigKey)) {
+		if cast.ToString(cast.ToStringMap(entry)["device"]) == deviceKey {
+			continue
+		}
+		rates = append(rates, entry)
+	}
+	config.Config.Set(baudRatesConfigKey, rates)
+}
+
+func (d *Driver) BenchmarkBaudRates(
+	ctx context.Context,
+	uri *url.URL,
+	rates []int,
+	duration time.Duration,
+) (results []devices.BaudRateResult, best int, err error) {
+	if uri.Scheme == tcpScheme {
+		err = devices.WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("%s: the baud rate of TCP serial bridges is configured on the bridge", driverName),
+		)
+		return
+	}
+
+	if len(rates) == 0 {
+		rates = baudRates
+	}
+	if duration <= 0 {
+		duration = defaultBenchmarkDuration
+	}
+
+	// the benchmark needs exclusive access to the serial port; keep the device from being reopened until it is done:
+	deviceKey := d.DeviceKey(uri)
+	if _, busy := d.benchmarking.LoadOrStore(deviceKey, struct{}{}); busy {
+		err = devices.WithCode(codes.Unavailable, fmt.Errorf("%s: device '%s' is already being benchmarked", driverName, deviceKey))
+		return
+	}
+	defer d.benchmarking.Delete(deviceKey)
+
+	if device, ok := d.container.GetDevice(deviceKey); ok {
+		log.Printf("%s: disconnecting device '%s' to benchmark it\n", driverName, deviceKey)
+		_ = device.Close()
+		d.container.DeleteDevice(deviceKey)
+	}
+
+	results = make([]devices.BaudRateResult, 0, len(rates))
+	bestRate := float64(0)
+	for _, baud := range rates {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+
+		result := d.benchmarkBaudRate(ctx, uri, baud, duration)
+		log.Printf(
+			"%s: benchmark(baud=%d): %.0f B/s, %d transfers, %d errors, err=%v\n",
+			driverName,
+			baud,
+			result.BytesPerSecond,
+			result.Transfers,
+			result.Errors,
+			result.Err,
+		)
+		results = append(results, result)
+
+		if result.Err == nil && result.Errors == 0 && result.Transfers > 0 && result.BytesPerSecond > bestRate {
+			best, bestRate = baud, result.BytesPerSecond
+		}
+	}
+
+	return
+}
+
+// benchmarkBaudRate alternates VGET and GET reads of ROM for duration and compares every read against the first.
+func (d *Driver) benchmarkBaudRate(ctx context.Context, uri *url.URL, baud int, duration time.Duration) (result devices.BaudRateResult) {
+	result.BaudRate = baud
+
+	var f serial.Port
+	f, result.Err = d.openPortAt(uri, baud)
+	if result.Err != nil {
+		return
+	}
+
+	dev := &Device{f: f}
+	defer dev.Close()
+
+	result.Err = dev.Init()
+	if result.Err != nil {
+		return
+	}
+
+	vgetExpected := make([]byte, 255*8)
+	vgetActual := make([]byte, 255*8)
+	vgetChunks := func(target []byte) []vgetChunk {
+		chunks := make([]vgetChunk, 8)
+		for i := range chunks {
+			chunks[i] = vgetChunk{size: 255, addr: benchmarkAddress + uint32(i*255), target: target[i*255:]}
+		}
+		return chunks
+	}
+
+	result.Err = dev.vget(ctx, SpaceSNES, vgetChunks(vgetExpected)...)
+	if result.Err != nil {
+		return
+	}
+	var getExpected []byte
+	getExpected, result.Err = dev.get(ctx, SpaceSNES, benchmarkAddress, benchmarkGetSize)
+	if result.Err != nil {
+		return
+	}
+
+	total := 0
+	start := time.Now()
+	for time.Since(start) < duration {
+		if ctx.Err() != nil {
+			result.Err = ctx.Err()
+			return
+		}
+
+		var err error
+		var ok bool
+		if result.Transfers&1 == 0 {
+			err = dev.vget(ctx, SpaceSNES, vgetChunks(vgetActual)...)
+			ok = bytes.Equal(vgetActual, vgetExpected)
+			total += len(vgetActual)
+		} else {
+			var data []byte
+			data, err = dev.get(ctx, SpaceSNES, benchmarkAddress, benchmarkGetSize)
+			ok = bytes.Equal(data, getExpected)
+			total += len(data)
+		}
+		result.Transfers++
+
+		if devices.IsFatal(err) {
+			result.Err = err
+			break
+		}
+		if err != nil || !ok {
+			result.Errors++
+		}
+	}
+
+	result.BytesPerSecond = float64(total) / time.Since(start).Seconds()
+	return
+}
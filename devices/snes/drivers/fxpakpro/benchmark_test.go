@@ -0,0 +1,41 @@
+package fxpakpro
+
+import (
+	"github.com/spf13/viper"
+	"net/url"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"testing"
+)
+
+func TestDriver_RememberBaudRate_SaveAndLoad(t *testing.T) {
+	saved := config.Config
+	t.Cleanup(func() { config.Config = saved })
+	config.Config = viper.New()
+
+	d := &Driver{}
+	// identity keys contain '.' which viper would split a map key at:
+	hub := identityUri("usb-1-1.2")
+	port := &url.URL{Scheme: driverName, Host: ".", Path: "COM4"}
+	d.RememberBaudRate(&hub, 921600)
+	d.RememberBaudRate(port, 115200)
+	d.RememberBaudRate(port, 460800)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := config.Config.WriteConfigAs(path); err != nil {
+		t.Fatal(err)
+	}
+
+	config.Config = viper.New()
+	config.Config.SetConfigFile(path)
+	if err := config.Config.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := rememberedBaudRate(d.DeviceKey(&hub)); got != 921600 {
+		t.Errorf("rememberedBaudRate(%s) = %d, want %d", &hub, got, 921600)
+	}
+	if got := rememberedBaudRate(d.DeviceKey(port)); got != 460800 {
+		t.Errorf("rememberedBaudRate(%s) = %d, want %d", port, got, 460800)
+	}
+}
@@ -5,14 +5,23 @@ import (
 	"fmt"
 	"go.bug.st/serial"
 	"sni/devices"
-	"sync"
+	"sni/protos/sni"
+	"sync/atomic"
 )
 
 type Device struct {
-	lock sync.Mutex
+	lock deviceLock
 	f    serial.Port
 
 	isClosed bool
+
+	// set once the firmware has rejected a STREAM request; read by concurrent StreamReadMemory calls:
+	streamUnsupported atomic.Bool
+
+	// info_flags most recently reported by INFO; unset until the first INFO reply:
+	features atomic.Value
+	// device name most recently reported by INFO, e.g. "sd2snes Mk.III":
+	name atomic.Value
 }
 
 func (d *Device) FatalError(cause error) devices.DeviceError {
@@ -23,8 +32,29 @@ func (d *Device) NonFatalError(cause error) devices.DeviceError {
 	return devices.DeviceNonFatal(fmt.Sprintf("fxpakpro: %v", cause), cause)
 }
 
-func (d *Device) Init() error {
-	return nil
+func (d *Device) Init() (err error) {
+	// query INFO to learn the firmware's features:
+	_, err = d.info(context.Background())
+	return
+}
+
+// capabilities returns driverCapabilities filtered by the firmware's most recently reported features. Until the
+// features are known, all driver capabilities are assumed.
+func (d *Device) capabilities() []sni.DeviceCapability {
+	flags, ok := d.features.Load().(info_flags)
+	if !ok || flags&FeatCMD_UNLOCK != 0 {
+		return driverCapabilities[:]
+	}
+
+	// executing code via the NMI hook requires the snescmd area to be unlocked:
+	caps := make([]sni.DeviceCapability, 0, len(driverCapabilities))
+	for _, c := range driverCapabilities {
+		if c == sni.DeviceCapability_ExecuteASM {
+			continue
+		}
+		caps = append(caps, c)
+	}
+	return caps
 }
 
 func (d *Device) IsClosed() bool {
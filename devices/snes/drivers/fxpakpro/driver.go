@@ -2,23 +2,34 @@ package fxpakpro
 
 import (
 	"fmt"
+	"github.com/alttpo/observable"
+	"github.com/spf13/viper"
 	"go.bug.st/serial"
 	"go.bug.st/serial/enumerator"
+	"google.golang.org/grpc/codes"
 	"log"
 	"net/url"
+	"os"
 	"runtime"
+	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
 	"sni/util"
 	"sni/util/env"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 const (
 	driverName = "fxpakpro"
+	// tcpScheme is the URI scheme of devices behind TCP serial bridges, e.g. "tcp://raspberrypi:2000":
+	tcpScheme = "tcp"
 )
 
+// tcpHostsConfigKey is the config.yaml key of the TCP serial bridges to report in Detect; see devices.ParseEndpoints
+const tcpHostsConfigKey = "fxpakproTcpHosts"
+
 var driver *Driver
 
 var (
@@ -44,6 +55,20 @@ const defaultAddressSpace = sni.AddressSpace_FxPakPro
 
 type Driver struct {
 	container devices.DeviceContainer
+
+	// names of simulated devices to report in Detect:
+	simulatorNames []string
+
+	tcpHostsLock sync.Mutex
+	// endpoints of TCP serial bridges to report in Detect:
+	tcpHosts []devices.Endpoint
+
+	// device names reported by INFO keyed by DeviceKey, remembered after the device is closed:
+	deviceNames sync.Map
+	// DeviceKeys of devices being benchmarked which must not be opened meanwhile:
+	benchmarking sync.Map
+	// stable identities of carts keyed by the name of the port they were last seen at:
+	identities sync.Map
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -67,6 +92,7 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ResetToMenu,
 	sni.DeviceCapability_ExecuteASM,
 	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_GetEmulationState,
 	// filesystem:
 	sni.DeviceCapability_ReadDirectory,
 	sni.DeviceCapability_MakeDirectory,
@@ -75,10 +101,41 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_PutFile,
 	sni.DeviceCapability_GetFile,
 	sni.DeviceCapability_BootFile,
+	sni.DeviceCapability_BootRom,
+	sni.DeviceCapability_ListMemoryRegions,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
-	return devices.CheckCapabilities(capabilities, driverCapabilities)
+	return devices.CheckCapabilities(capabilities, d.probedCapabilities())
+}
+
+// probedCapabilities combines the capabilities of all opened devices as refined by their reported features. With no
+// devices opened, all driver capabilities are assumed.
+func (d *Driver) probedCapabilities() (caps []sni.DeviceCapability) {
+	opened := false
+	caps = make([]sni.DeviceCapability, 0, len(driverCapabilities))
+	for _, deviceKey := range d.container.AllDeviceKeys() {
+		device, ok := d.container.GetDevice(deviceKey)
+		if !ok {
+			continue
+		}
+		fxpak, ok := device.(*Device)
+		if !ok {
+			continue
+		}
+
+		opened = true
+		for _, c := range fxpak.capabilities() {
+			if ok, _ = devices.CheckCapabilities([]sni.DeviceCapability{c}, caps); !ok {
+				caps = append(caps, c)
+			}
+		}
+	}
+
+	if !opened {
+		return driverCapabilities[:]
+	}
+	return
 }
 
 func (d *Driver) DisconnectAll() {
@@ -97,44 +154,119 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 
 	devs = make([]devices.DeviceDescriptor, 0, 2)
 
-	ports, err = enumerator.GetDetailedPortsList()
+	ports, err = listPorts()
 	if err != nil {
 		return
 	}
 
+	identities := d.learnIdentities(ports)
 	for _, port := range ports {
-		if !port.IsUSB {
+		if !isFxPakPro(port) {
 			continue
 		}
 
 		// When more than one fxpakpro is connected only one of the devices gets the SerialNumber="DEMO00000000";
-		// This is likely a bug in serial library.
-		if (port.SerialNumber == "DEMO00000000") || (port.VID == "1209" && port.PID == "5A22") {
-			devs = append(devs, devices.DeviceDescriptor{
-				Uri:                 url.URL{Scheme: driverName, Host: ".", Path: port.Name},
-				DisplayName:         fmt.Sprintf("%s (%s:%s)", port.Name, port.VID, port.PID),
-				Kind:                d.Kind(),
-				Capabilities:        driverCapabilities[:],
-				DefaultAddressSpace: defaultAddressSpace,
-				System:              "snes",
-			})
+		// This is likely a bug in serial library. Prefer the stable identity so the URI survives replugs.
+		identity := identities[port.Name]
+		uri := url.URL{Scheme: driverName, Host: ".", Path: port.Name}
+		if identity != "" {
+			uri = identityUri(identity)
+		}
+		caps, _ := d.openedCapabilities(&uri)
+
+		displayName := fmt.Sprintf("%s (%s:%s)", port.Name, port.VID, port.PID)
+		if name, ok := d.deviceNames.Load(d.DeviceKey(&uri)); ok {
+			displayName = fmt.Sprintf("%s %s", name, displayName)
+		}
+
+		devs = append(devs, devices.DeviceDescriptor{
+			Uri:                 uri,
+			Id:                  identity,
+			DisplayName:         displayName,
+			Kind:                d.Kind(),
+			Capabilities:        caps,
+			DefaultAddressSpace: defaultAddressSpace,
+			System:              "snes",
+		})
+	}
+
+	// bridges are configured explicitly so report them without connecting; opening an unreachable one fails instead:
+	d.tcpHostsLock.Lock()
+	tcpHosts := d.tcpHosts
+	d.tcpHostsLock.Unlock()
+	for _, endpoint := range tcpHosts {
+		uri := url.URL{Scheme: tcpScheme, Host: endpoint.Address}
+		caps, _ := d.openedCapabilities(&uri)
+
+		displayName := fmt.Sprintf("%s (TCP)", endpoint.Address)
+		if name, ok := d.deviceNames.Load(d.DeviceKey(&uri)); ok {
+			displayName = fmt.Sprintf("%s %s", name, displayName)
 		}
+		if endpoint.Name != "" {
+			displayName = fmt.Sprintf("%s - %s", endpoint.Name, displayName)
+		}
+
+		devs = append(devs, devices.DeviceDescriptor{
+			Uri:                 uri,
+			DisplayName:         displayName,
+			Kind:                d.Kind(),
+			Capabilities:        caps,
+			DefaultAddressSpace: defaultAddressSpace,
+			System:              "snes",
+		})
+	}
+
+	for _, name := range d.simulatorNames {
+		devs = append(devs, devices.DeviceDescriptor{
+			Uri:                 url.URL{Scheme: driverName, Host: "sim", Path: "/" + name},
+			DisplayName:         fmt.Sprintf("Simulator (%s)", name),
+			Kind:                d.Kind(),
+			Capabilities:        driverCapabilities[:],
+			DefaultAddressSpace: defaultAddressSpace,
+			System:              "snes",
+		})
 	}
 
 	err = nil
 	return
 }
 
-func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err error) {
-	f = serial.Port(nil)
+// openedCapabilities refines driverCapabilities by the reported features if the device is already open
+func (d *Driver) openedCapabilities(uri *url.URL) (caps []sni.DeviceCapability, opened bool) {
+	caps = driverCapabilities[:]
+	if device, ok := d.container.GetDevice(d.DeviceKey(uri)); ok {
+		opened = true
+		if fxpak, ok := device.(*Device); ok {
+			caps = fxpak.capabilities()
+		}
+	}
+	return
+}
 
-	// Try all the common baud rates in descending order:
-	var baud int
-	for _, baud = range baudRates {
+func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err error) {
+	// Try the requested rate first in case it is non-standard, then all the common baud rates in descending order:
+	rates := make([]int, 0, len(baudRates)+1)
+	rates = append(rates, baudRequest)
+	for _, baud := range baudRates {
+		if baud == baudRequest {
+			continue
+		}
 		if baud > baudRequest {
 			continue
 		}
+		rates = append(rates, baud)
+	}
+
+	return d.openPortRates(portName, rates)
+}
 
+// openPortRates opens portName at the first of rates that works:
+func (d *Driver) openPortRates(portName string, rates []int) (f serial.Port, err error) {
+	f = serial.Port(nil)
+	err = fmt.Errorf("no baud rate to try")
+
+	var baud int
+	for _, baud = range rates {
 		log.Printf("%s: open(name=\"%s\", baud=%d)\n", driverName, portName, baud)
 		f, err = serial.Open(portName, &serial.Mode{
 			BaudRate: baud,
@@ -162,7 +294,22 @@ func (d *Driver) openPort(portName string, baudRequest int) (f serial.Port, err
 	return
 }
 
+// DeviceKey identifies the device uri refers to. A local serial port is keyed by the stable identity of the cart
+// connected to it, if any, so that the identity URI and the port URI of the same cart share one opened device.
 func (d *Driver) DeviceKey(uri *url.URL) (key string) {
+	if uri.Scheme == tcpScheme {
+		return "tcp/" + uri.Host
+	}
+	if uri.Host == "sim" {
+		return "sim" + uri.Path
+	}
+	if uri.Host == identityHost {
+		return identityKey(uri.Path)
+	}
+	if identity := d.portIdentity(uri.Path); identity != "" {
+		return identityKey(identity)
+	}
+
 	key = uri.Path
 	// macos/linux paths:
 	if strings.HasPrefix(key, "/dev/") {
@@ -177,8 +324,44 @@ func (d *Driver) DeviceKey(uri *url.URL) (key string) {
 	return
 }
 
+// portName returns the name of the local serial port uri refers to:
+func (d *Driver) portName(uri *url.URL) (portName string, err error) {
+	if uri.Host != identityHost {
+		return uri.Path, nil
+	}
+
+	var ok bool
+	portName, ok, err = resolveIdentity(uri.Path)
+	if err != nil {
+		return
+	}
+	if !ok {
+		err = fmt.Errorf("%s: no device with identity '%s' is connected", driverName, strings.Trim(uri.Path, "/"))
+		return
+	}
+	return
+}
+
+// openPortAt opens the local serial port or simulator uri refers to at exactly baud:
+func (d *Driver) openPortAt(uri *url.URL, baud int) (f serial.Port, err error) {
+	if uri.Host == "sim" {
+		return Simulated(uri.Path).Open(), nil
+	}
+
+	var portName string
+	portName, err = d.portName(uri)
+	if err != nil {
+		return
+	}
+
+	return d.openPortRates(portName, []int{baud})
+}
+
 func (d *Driver) openDevice(uri *url.URL) (device devices.Device, err error) {
-	portName := uri.Path
+	if _, busy := d.benchmarking.Load(d.DeviceKey(uri)); busy {
+		err = devices.WithCode(codes.Unavailable, fmt.Errorf("%s: device is being benchmarked", driverName))
+		return
+	}
 
 	var baudRequest int
 	if runtime.GOOS == "darwin" {
@@ -186,18 +369,55 @@ func (d *Driver) openDevice(uri *url.URL) (device devices.Device, err error) {
 	} else {
 		baudRequest = baudRates[0]
 	}
+	if baud := rememberedBaudRate(d.DeviceKey(uri)); baud > 0 {
+		// found by BenchmarkBaudRates:
+		baudRequest = baud
+	}
 	if baudStr := uri.Query().Get("baud"); baudStr != "" {
 		baudRequest, _ = strconv.Atoi(baudStr)
 	}
 
 	var f serial.Port
-	f, err = d.openPort(portName, baudRequest)
-	if err != nil {
+	switch {
+	case uri.Scheme == tcpScheme:
+		// the bridge on the remote end owns the serial port settings:
+		f, err = openTCPPort(uri.Host)
+		if err != nil {
+			err = devices.WithCode(
+				codes.Unavailable,
+				fmt.Errorf("%s: failed to connect to serial bridge at %s: %w", driverName, uri.Host, err),
+			)
+			return
+		}
+	case uri.Host == "sim":
+		f = Simulated(uri.Path).Open()
+	case uri.Host == "." || uri.Host == "" || uri.Host == identityHost:
+		var portName string
+		portName, err = d.portName(uri)
+		if err != nil {
+			return
+		}
+		f, err = d.openPort(portName, baudRequest)
+		if err != nil {
+			return
+		}
+	default:
+		err = devices.WithCode(
+			codes.InvalidArgument,
+			fmt.Errorf("%s: unknown host '%s'; use %s://%s for TCP serial bridges", driverName, uri.Host, tcpScheme, uri.Host),
+		)
 		return
 	}
 
 	dev := &Device{f: f}
 	err = dev.Init()
+	if err != nil {
+		_ = dev.Close()
+		return
+	}
+	if name, ok := dev.name.Load().(string); ok && name != "" {
+		d.deviceNames.Store(d.DeviceKey(uri), name)
+	}
 
 	device = dev
 	return
@@ -229,6 +449,67 @@ func DriverInit() {
 	}
 
 	driver = &Driver{}
+	for _, name := range strings.Split(env.GetOrDefault("SNI_FXPAKPRO_SIM", ""), ",") {
+		name = strings.Trim(strings.TrimSpace(name), "/")
+		if name == "" {
+			continue
+		}
+		driver.simulatorNames = append(driver.simulatorNames, name)
+	}
+
+	// comma-delimited list of host:port pairs; overrides the config.yaml list:
+	envTCPHosts = os.Getenv("SNI_FXPAKPRO_TCP_HOSTS")
+	if envTCPHosts != "" {
+		log.Printf("%s: using SNI_FXPAKPRO_TCP_HOSTS='%s' instead of %s from config.yaml\n", driverName, envTCPHosts, tcpHostsConfigKey)
+	}
+	endpoints, err := configuredTCPHosts(config.Config)
+	if err != nil {
+		log.Printf("%s: %v\n", driverName, err)
+	}
+	driver.SetTCPHosts(endpoints)
+
 	driver.container = devices.NewDeviceDriverContainer(driver.openDevice)
 	devices.Register(driverName, driver)
+	devices.RegisterScheme(tcpScheme, driverName)
+
+	// add and remove bridges as config.yaml changes:
+	if config.ConfigObservable != nil {
+		config.ConfigObservable.Subscribe(observable.NewObserver(driverName, func(event observable.Event) {
+			v, ok := event.Value.(*viper.Viper)
+			if !ok || v == nil {
+				return
+			}
+
+			endpoints, err := configuredTCPHosts(v)
+			if err != nil {
+				// keep reporting the previous bridges until the config is fixed:
+				log.Printf("%s: %v\n", driverName, err)
+				return
+			}
+			driver.SetTCPHosts(endpoints)
+		}))
+	}
+}
+
+// SetTCPHosts replaces the TCP serial bridges to report in Detect; devices opened at removed bridges stay open.
+func (d *Driver) SetTCPHosts(endpoints []devices.Endpoint) {
+	defer d.tcpHostsLock.Unlock()
+	d.tcpHostsLock.Lock()
+	d.tcpHosts = endpoints
+}
+
+// envTCPHosts is the value of SNI_FXPAKPRO_TCP_HOSTS which overrides config.yaml if set:
+var envTCPHosts string
+
+// configuredTCPHosts returns the bridges from SNI_FXPAKPRO_TCP_HOSTS, else from config.yaml, else none.
+func configuredTCPHosts(v *viper.Viper) (endpoints []devices.Endpoint, err error) {
+	var value interface{}
+	if envTCPHosts != "" {
+		value = envTCPHosts
+	} else {
+		value = v.Get(tcpHostsConfigKey)
+	}
+
+	endpoints, err = devices.ParseEndpoints(value)
+	return
 }
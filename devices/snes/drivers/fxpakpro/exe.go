@@ -0,0 +1,182 @@
+package fxpakpro
+
+import (
+	"context"
+	"fmt"
+	"github.com/alttpo/snes/asm"
+	"github.com/alttpo/snes/timing"
+	"google.golang.org/grpc/codes"
+	"sni/devices"
+)
+
+// layout of the snescmd area in CMD space used to execute code during NMI:
+const (
+	// a non-zero byte at $2C00 makes the firmware's NMI hook jump to $2C00; the routine clears it once done:
+	exeHookAddr = 0x2C00
+	// code and data follow the hook routine and must end before the result buffer:
+	exeCodeAddr   = exeHookAddr + exeHookSize
+	exeResultAddr = 0x2E00
+	exeResultSize = 0x200
+
+	exeHookSize = 0x28
+	exeMaxSize  = exeResultAddr - exeCodeAddr
+)
+
+// GenerateExecuteAsm emits the NMI hook routine that calls code with JSR and places data directly after it. code is
+// called in bank $00 with 16-bit A, X and Y, the data bank register set to $00, X pointing to data and Y pointing to
+// the result buffer at $2E00, and must return with RTS.
+func GenerateExecuteAsm(a *asm.Emitter, code []byte, data []byte) {
+	a.SetBase(exeHookAddr)
+
+	// this NOP slide is necessary to avoid the problematic $2C00 address itself.
+	a.NOP()
+	a.NOP()
+
+	a.Comment("preserve registers:")
+	a.REP(0x30)
+	a.PHA()
+	a.PHX()
+	a.PHY()
+	a.PHD()
+	a.PHB()
+
+	a.Comment("call code with X = data, Y = result buffer, DB = $00:")
+	a.PHK()
+	a.PLB()
+	a.LDX_imm16_w(uint16(exeCodeAddr + len(code)))
+	a.LDY_imm16_w(exeResultAddr)
+	a.JSR_abs(exeCodeAddr)
+
+	a.REP(0x30)
+	a.PLB()
+
+	a.Comment("disable NMI vector override:")
+	a.SEP(0x30)
+	a.LDA_imm8_b(0x00)
+	a.STA_long(0x002C00)
+
+	a.Comment("restore registers:")
+	a.REP(0x30)
+	a.PLD()
+	a.PLY()
+	a.PLX()
+	a.PLA()
+
+	a.Comment("jump to original NMI:")
+	a.JMP_indirect(0xFFEA)
+
+	// bug check: make sure emitted code is the expected size
+	if actual, expected := a.Len(), exeHookSize; actual != expected {
+		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+	}
+
+	a.Comment("code:")
+	a.EmitBytes(code)
+	if len(data) > 0 {
+		a.Comment("data:")
+		a.EmitBytes(data)
+	}
+}
+
+// appendVputChunks splits data written to addr into VPUT chunks of at most 255 bytes:
+func appendVputChunks(chunks []vputChunk, addr uint32, data []byte) []vputChunk {
+	for len(data) > 0 {
+		chunkSize := 255
+		if len(data) < chunkSize {
+			chunkSize = len(data)
+		}
+		chunks = append(chunks, vputChunk{addr: addr, data: data[:chunkSize]})
+		data = data[chunkSize:]
+		addr += uint32(chunkSize)
+	}
+	return chunks
+}
+
+// ExecuteASM runs code once during NMI using the USB EXE hook at $2C00 in CMD space and returns the first resultSize
+// bytes of the result buffer at $2E00. See GenerateExecuteAsm for the environment code is called in.
+func (d *Device) ExecuteASM(ctx context.Context, code []byte, data []byte, resultSize int) (result []byte, err error) {
+	if flags, ok := d.features.Load().(info_flags); ok && flags&FeatCMD_UNLOCK == 0 {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("fxpakpro: firmware does not support FEAT_CMD_UNLOCK"))
+		return
+	}
+	if actual, expected := len(code)+len(data), exeMaxSize; actual > expected {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: code and data too large; %d > %d", actual, expected))
+		return
+	}
+	if resultSize < 0 || resultSize > exeResultSize {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("fxpakpro: resultSize must be at most %d", exeResultSize))
+		return
+	}
+
+	program := [exeResultAddr - exeHookAddr]byte{}
+	a := asm.NewEmitter(program[:], false)
+	GenerateExecuteAsm(a, code, data)
+
+	// write everything but the first byte of the routine and clear the result buffer, then write the first byte last
+	// so the hook cannot run a partially written routine:
+	chunks := make([]vputChunk, 0, 8)
+	chunks = appendVputChunks(chunks, exeHookAddr+1, program[1:a.Len()])
+	chunks = appendVputChunks(chunks, exeResultAddr, make([]byte, resultSize))
+	chunks = append(chunks, vputChunk{addr: exeHookAddr, data: program[0:1]})
+
+	subctx := ctx
+	if shouldLock(ctx) {
+		defer d.lock.Unlock()
+		d.lock.Lock()
+		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
+	}
+
+	// await 5 seconds in game-frames for USB EXE:
+	awaitctx, awaitcancel := context.WithTimeout(subctx, timing.Frame*60*5)
+	defer awaitcancel()
+
+	var ok bool
+	ok, err = d.awaitUSBEXE(awaitctx)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-execute: %w", err)
+		return
+	}
+	if !ok {
+		err = fmt.Errorf("fxpakpro: could not acquire USB EXE pre-execute")
+		return
+	}
+
+	err = d.vput(awaitctx, SpaceCMD, chunks...)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not VPUT to USB EXE: %w", err)
+		return
+	}
+
+	// the routine releases USB EXE once the code returned:
+	ok, err = d.awaitUSBEXE(awaitctx)
+	if err != nil {
+		err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-execute: %w", err)
+		return
+	}
+	if !ok {
+		err = fmt.Errorf("fxpakpro: could not acquire USB EXE post-execute")
+		return
+	}
+
+	result = make([]byte, resultSize)
+	reads := make([]vgetChunk, 0, 3)
+	for offs := 0; offs < resultSize; offs += 255 {
+		chunkSize := resultSize - offs
+		if chunkSize > 255 {
+			chunkSize = 255
+		}
+		reads = append(reads, vgetChunk{
+			size:   byte(chunkSize),
+			addr:   uint32(exeResultAddr + offs),
+			target: result[offs:],
+		})
+	}
+	if len(reads) > 0 {
+		err = d.vget(subctx, SpaceCMD, reads...)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
@@ -1,7 +1,10 @@
 package fxpakpro
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
 	"io"
 	"sni/devices"
 )
@@ -35,3 +38,36 @@ func (d *Device) GetFile(ctx context.Context, path string, w io.Writer, sizeRece
 func (d *Device) BootFile(ctx context.Context, path string) error {
 	return d.boot(ctx, path)
 }
+
+// bootRomDirectory is the directory on the SD card BootRom uploads ROMs to:
+const bootRomDirectory = "/sni"
+
+// BootRom uploads the ROM to bootRomDirectory, replacing any ROM of the same name, and boots it. The ROM must be sent
+// in Rom; a HostPath would copy a file from SNI's host to the SD card where GetFile could read it back.
+func (d *Device) BootRom(ctx context.Context, source devices.BootRomSource) (path string, err error) {
+	if source.HostPath != "" {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("%s: send the ROM in rom instead of hostPath", driverName))
+		return
+	}
+
+	var rom []byte
+	rom, err = source.Contents()
+	if err != nil {
+		return
+	}
+
+	// the directory usually exists already:
+	err = d.mkdir(ctx, bootRomDirectory)
+	if err != nil && devices.IsFatal(err) {
+		return
+	}
+
+	path = bootRomDirectory + "/" + source.Name()
+	_, err = d.putFile(ctx, path, uint32(len(rom)), bytes.NewReader(rom), nil)
+	if err != nil {
+		return
+	}
+
+	err = d.boot(ctx, path)
+	return
+}
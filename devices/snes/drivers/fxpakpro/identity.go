@@ -0,0 +1,125 @@
+package fxpakpro
+
+import (
+	"go.bug.st/serial/enumerator"
+	"net/url"
+	"strings"
+)
+
+// sharedSerialNumber is reported for every cart when more than one is connected, so it cannot identify a device:
+const sharedSerialNumber = "DEMO00000000"
+
+// identityHost is the URI host that selects a device by its stable identity, e.g. "fxpakpro://id/usb-1-1.2":
+const identityHost = "id"
+
+// listPorts enumerates the serial ports; replaced in tests:
+var listPorts = enumerator.GetDetailedPortsList
+
+func isFxPakPro(port *enumerator.PortDetails) bool {
+	if !port.IsUSB {
+		return false
+	}
+	return (port.SerialNumber == sharedSerialNumber) || (port.VID == "1209" && port.PID == "5A22")
+}
+
+// stableIdentity derives an identity for a cart that survives replugs and reboots. A USB serial number is used when it
+// is unique among the connected carts, otherwise the USB location path of the port the cart is plugged into. Returns
+// "" if neither is available. The device name reported by INFO is not part of the identity since it is only known once
+// the device is opened, which already requires its key, and every cart with the same firmware reports the same name.
+func stableIdentity(serialNumber string, serialShared bool, location string) string {
+	if serialNumber != "" && serialNumber != sharedSerialNumber && !serialShared {
+		return "sn-" + serialNumber
+	}
+	if location != "" {
+		return "usb-" + location
+	}
+	return ""
+}
+
+// portIdentities maps the names of all connected fxpakpro ports to their stable identity, if any.
+func portIdentities(ports []*enumerator.PortDetails) (identities map[string]string) {
+	serialCounts := make(map[string]int, len(ports))
+	for _, port := range ports {
+		if isFxPakPro(port) {
+			serialCounts[port.SerialNumber]++
+		}
+	}
+
+	identities = make(map[string]string, len(ports))
+	for _, port := range ports {
+		if !isFxPakPro(port) {
+			continue
+		}
+		identities[port.Name] = stableIdentity(
+			port.SerialNumber,
+			serialCounts[port.SerialNumber] > 1,
+			portLocation(port.Name),
+		)
+	}
+	return
+}
+
+// identityUri returns the URI that selects the device with the given identity.
+func identityUri(identity string) url.URL {
+	return url.URL{Scheme: driverName, Host: identityHost, Path: "/" + identity}
+}
+
+// identityKey is the device key of the device with the given identity:
+func identityKey(identity string) string {
+	return "id/" + strings.Trim(identity, "/")
+}
+
+// learnIdentities remembers the stable identities of the carts connected to ports. Identities of carts that are no
+// longer connected are kept so that the port URI of an unplugged cart keeps the key of the device that may still be
+// open.
+func (d *Driver) learnIdentities(ports []*enumerator.PortDetails) (identities map[string]string) {
+	identities = portIdentities(ports)
+	for name, id := range identities {
+		d.identities.Store(name, id)
+	}
+	return
+}
+
+// portIdentity looks up the stable identity of the cart last seen at the port with the given name, if any. DeviceKey
+// is called for every request so identities are learned by Detect; only a port Detect has not seen enumerates ports.
+func (d *Driver) portIdentity(portName string) string {
+	lookup := func() (string, bool) {
+		if id, ok := d.identities.Load(portName); ok {
+			return id.(string), true
+		}
+		if id, ok := d.identities.Load(strings.TrimPrefix(portName, "/")); ok {
+			return id.(string), true
+		}
+		return "", false
+	}
+
+	if id, ok := lookup(); ok {
+		return id
+	}
+
+	ports, err := listPorts()
+	if err != nil {
+		return ""
+	}
+	d.learnIdentities(ports)
+
+	id, _ := lookup()
+	return id
+}
+
+// resolveIdentity finds the name of the port the device with the given identity is connected to.
+func resolveIdentity(identity string) (portName string, ok bool, err error) {
+	var ports []*enumerator.PortDetails
+	ports, err = listPorts()
+	if err != nil {
+		return
+	}
+
+	identity = strings.Trim(identity, "/")
+	for name, id := range portIdentities(ports) {
+		if id != "" && id == identity {
+			return name, true, nil
+		}
+	}
+	return
+}
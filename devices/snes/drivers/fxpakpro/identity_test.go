@@ -0,0 +1,89 @@
+package fxpakpro
+
+import (
+	"go.bug.st/serial/enumerator"
+	"net/url"
+	"testing"
+)
+
+func TestStableIdentity(t *testing.T) {
+	tests := []struct {
+		name         string
+		serialNumber string
+		serialShared bool
+		location     string
+		want         string
+	}{
+		{name: "unique serial", serialNumber: "ABC123", location: "1-1.2", want: "sn-ABC123"},
+		{name: "shared serial", serialNumber: "ABC123", serialShared: true, location: "1-1.2", want: "usb-1-1.2"},
+		{name: "demo serial", serialNumber: sharedSerialNumber, location: "1-1.3", want: "usb-1-1.3"},
+		{name: "no serial", location: "2-4", want: "usb-2-4"},
+		{name: "unknown", serialNumber: sharedSerialNumber, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := stableIdentity(tt.serialNumber, tt.serialShared, tt.location); got != tt.want {
+				t.Errorf("stableIdentity() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDriver_DeviceKey_Identity(t *testing.T) {
+	d := &Driver{}
+
+	uri := identityUri("usb-1-1.2")
+	if got, want := uri.String(), "fxpakpro://id/usb-1-1.2"; got != want {
+		t.Fatalf("identityUri() = %q, want %q", got, want)
+	}
+
+	parsed, err := url.Parse("fxpakpro://id/usb-1-1.2?baud=115200")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.DeviceKey(parsed), d.DeviceKey(&uri); got != want {
+		t.Errorf("DeviceKey() = %q, want %q", got, want)
+	}
+}
+
+func TestDriver_DeviceKey_PortOfIdentity(t *testing.T) {
+	d := &Driver{}
+
+	enumerations := 0
+	connected := []*enumerator.PortDetails{
+		{Name: "/dev/ttySNITEST0", IsUSB: true, VID: "1209", PID: "5A22", SerialNumber: "ABC123"},
+		{Name: "/dev/ttySNITEST1", IsUSB: true, VID: "1209", PID: "5A22", SerialNumber: sharedSerialNumber},
+	}
+	listPorts = func() ([]*enumerator.PortDetails, error) {
+		enumerations++
+		return connected, nil
+	}
+	t.Cleanup(func() { listPorts = enumerator.GetDetailedPortsList })
+
+	// the port and identity URIs of the same cart must not open the port twice:
+	identity := identityUri("sn-ABC123")
+	port := url.URL{Scheme: driverName, Host: ".", Path: "/dev/ttySNITEST0"}
+	if got, want := d.DeviceKey(&port), d.DeviceKey(&identity); got != want {
+		t.Errorf("DeviceKey(%s) = %q, want %q", &port, got, want)
+	}
+
+	// a port without an identity is keyed by its name:
+	other := url.URL{Scheme: driverName, Host: ".", Path: "/dev/ttySNITEST1"}
+	if got, want := d.DeviceKey(&other), "ttySNITEST1"; got != want {
+		t.Errorf("DeviceKey(%s) = %q, want %q", &other, got, want)
+	}
+
+	// ports are only enumerated for the first port that was not detected yet:
+	if enumerations != 1 {
+		t.Errorf("DeviceKey() enumerated ports %d times, want 1", enumerations)
+	}
+
+	// an unplugged cart keeps the key of the device that may still be open:
+	connected = nil
+	if _, err := d.Detect(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := d.DeviceKey(&port), d.DeviceKey(&identity); got != want {
+		t.Errorf("DeviceKey(%s) after unplug = %q, want %q", &port, got, want)
+	}
+}
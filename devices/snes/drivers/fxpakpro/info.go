@@ -1,17 +1,20 @@
 package fxpakpro
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"github.com/alttpo/snes"
+	"path"
+	"sni/devices"
+	"sni/devices/snes/mapping"
 	"sni/protos/sni"
+	"strings"
 )
 
 func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
-	var version string
-	var deviceName string
-	var rom string
-
-	version, deviceName, rom, err = d.info(ctx)
+	var inf deviceInfo
+	inf, err = d.info(ctx)
 	if err != nil {
 		return
 	}
@@ -19,13 +22,29 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 	for _, field := range fields {
 		switch field {
 		case sni.Field_DeviceName:
-			values = append(values, deviceName)
+			values = append(values, inf.device)
 			break
 		case sni.Field_DeviceVersion:
-			values = append(values, version)
+			values = append(values, inf.version)
+			break
+		case sni.Field_DeviceFeatures:
+			values = append(values, strings.Join(inf.flags.features(), ","))
+			break
+		case sni.Field_DeviceProtocolVersion:
+			values = append(values, inf.protocolVersion())
 			break
 		case sni.Field_RomFileName:
-			values = append(values, rom)
+			values = append(values, inf.rom)
+			break
+		case sni.Field_RomChips:
+			var chips []string
+			if !inf.inMenu() {
+				chips, err = d.romChips(ctx)
+				if err != nil {
+					return
+				}
+			}
+			values = append(values, strings.Join(chips, ","))
 			break
 		default:
 			// unknown value; append empty string to maintain index association:
@@ -37,7 +56,82 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 	return
 }
 
-func (d *Device) info(ctx context.Context) (version, device, rom string, err error) {
+// romChips reads the header of the running ROM to determine its enhancement chips; the INFO feature flags only
+// describe what the firmware supports:
+func (d *Device) romChips(ctx context.Context) (chips []string, err error) {
+	var header *snes.Header
+	header, err = d.romHeader(ctx)
+	if err != nil || header == nil {
+		// no recognizable ROM header so no chips can be declared:
+		return
+	}
+
+	chips = mapping.Chips(header)
+	return
+}
+
+// romHeader reads the header of the running ROM; header is nil if there is no recognizable header.
+func (d *Device) romHeader(ctx context.Context) (header *snes.Header, err error) {
+	var headerBytes []byte
+	_, _, headerBytes, err = mapping.Detect(ctx, d, nil, nil)
+	if err != nil {
+		if !devices.IsFatal(err) {
+			err = nil
+		}
+		return
+	}
+
+	header = &snes.Header{}
+	err = header.ReadHeader(bytes.NewReader(headerBytes))
+	if err != nil {
+		header = nil
+	}
+	return
+}
+
+// GetEmulationState reports whether the FX Pak Pro is in its menu or running a game; it cannot be paused.
+func (d *Device) GetEmulationState(ctx context.Context) (status devices.EmulationStatus, err error) {
+	var inf deviceInfo
+	inf, err = d.info(ctx)
+	if err != nil {
+		return
+	}
+
+	status.RomFileName = inf.rom
+	if inf.inMenu() {
+		status.State = sni.EmulationState_InMenu
+	} else {
+		status.State = sni.EmulationState_Running
+	}
+	return
+}
+
+type deviceInfo struct {
+	version string
+	device  string
+	rom     string
+	flags   info_flags
+}
+
+// protocolVersion extracts the USB protocol version from the firmware version string, e.g. "1.10.3-usb-v9" -> "9"
+func (inf *deviceInfo) protocolVersion() string {
+	i := strings.LastIndex(inf.version, "-usb-v")
+	if i < 0 {
+		return ""
+	}
+	return inf.version[i+len("-usb-v"):]
+}
+
+// inMenu is true if the firmware reports its menu ROM as running, e.g. "/sd2snes/m3nu.bin"
+func (inf *deviceInfo) inMenu() bool {
+	switch strings.ToLower(path.Base(inf.rom)) {
+	case "m3nu.bin", "menu.bin", ".", "/":
+		return true
+	}
+	return false
+}
+
+func (d *Device) info(ctx context.Context) (inf deviceInfo, err error) {
 	sb := make([]byte, 512)
 	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
 	sb[4] = byte(OpINFO)
@@ -78,14 +172,20 @@ func (d *Device) info(ctx context.Context) (version, device, rom string, err err
 		return
 	}
 
+	inf.flags = info_flags(sb[6])
+
 	romB := sb[16:252]
-	rom = string(romB[:clen(romB)])
+	inf.rom = string(romB[:clen(romB)])
 
 	versionB := sb[260 : 260+64]
-	version = string(versionB[:clen(versionB)])
+	inf.version = string(versionB[:clen(versionB)])
 
 	deviceB := sb[260+64 : 260+64+64]
-	device = string(deviceB[:clen(deviceB)])
+	inf.device = string(deviceB[:clen(deviceB)])
+
+	// remember the most recently reported features for capability detection:
+	d.features.Store(inf.flags)
+	d.name.Store(inf.device)
 
 	return
 }
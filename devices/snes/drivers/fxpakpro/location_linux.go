@@ -0,0 +1,18 @@
+//go:build linux
+// +build linux
+
+package fxpakpro
+
+import (
+	"path/filepath"
+)
+
+// portLocation returns the USB location path (e.g. "1-1.2") of the device behind a tty port by following its sysfs
+// link, e.g. /sys/class/tty/ttyACM0/device -> .../usb1/1-1/1-1.2/1-1.2:1.0
+func portLocation(portName string) string {
+	p, err := filepath.EvalSymlinks(filepath.Join("/sys/class/tty", filepath.Base(portName), "device"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(filepath.Dir(p))
+}
@@ -0,0 +1,11 @@
+//go:build !linux
+// +build !linux
+
+package fxpakpro
+
+// portLocation is not available on this OS; Windows COM port numbers and MacOS device names are already assigned per
+// USB port by the OS. Carts that share the DEMO00000000 serial number therefore have no stable identity here and are
+// only listed by their port URIs.
+func portLocation(portName string) string {
+	return ""
+}
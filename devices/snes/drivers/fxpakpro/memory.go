@@ -3,8 +3,10 @@ package fxpakpro
 import (
 	"context"
 	"fmt"
+	"github.com/alttpo/snes"
 	"github.com/alttpo/snes/asm"
 	"github.com/alttpo/snes/timing"
+	"google.golang.org/grpc/codes"
 	"sni/devices"
 	"sni/devices/snes/mapping"
 	"sni/protos/sni"
@@ -14,15 +16,60 @@ import (
 type subspace int
 
 const (
-	spaceSNES subspace = 0
-	spaceCMD  subspace = 1
+	spaceSNES   subspace = 0
+	spaceCMD    subspace = 1
+	spaceMSU    subspace = 2
+	spaceCONFIG subspace = 3
 )
 
+// isPakOnlySpace reports whether the address space is one only the FX Pak Pro firmware can address:
+func isPakOnlySpace(addressSpace sni.AddressSpace) bool {
+	return addressSpace == sni.AddressSpace_FxPakProMSU || addressSpace == sni.AddressSpace_FxPakProConfig
+}
+
+// deviceSpaceFor determines the address space to translate a request address into; MSU and CONFIG addresses stay in
+// their own spaces and everything else is translated to the FxPakPro space:
+func deviceSpaceFor(addressSpace sni.AddressSpace) sni.AddressSpace {
+	if isPakOnlySpace(addressSpace) {
+		return addressSpace
+	}
+	return sni.AddressSpace_FxPakPro
+}
+
+// splitSubspace determines which of the firmware's spaces a device address refers to. In the FxPakPro address space
+// the top byte selects $00_xxxxxx = SNES or $01_xxxxxx = CMD:
+func splitSubspace(address devices.AddressTuple) (sub subspace, pakSpace space, addr uint32, err error) {
+	switch address.AddressSpace {
+	case sni.AddressSpace_FxPakProMSU:
+		sub, pakSpace = spaceMSU, SpaceMSU
+	case sni.AddressSpace_FxPakProConfig:
+		sub, pakSpace = spaceCONFIG, SpaceCONFIG
+	default:
+		switch address.Address >> 24 {
+		case 0x00:
+			sub, pakSpace = spaceSNES, SpaceSNES
+		case 0x01:
+			sub, pakSpace = spaceCMD, SpaceCMD
+		default:
+			err = fmt.Errorf("fxpakpro: unrecognized address space selector $%02x in address $%08x", address.Address>>24, address.Address)
+			return
+		}
+	}
+
+	if address.Address > 0xFF_FFFF && isPakOnlySpace(address.AddressSpace) {
+		err = fmt.Errorf("fxpakpro: address $%08x out of range for %s space", address.Address, address.AddressSpace)
+		return
+	}
+
+	addr = address.Address & 0x00_FFFFFF
+	return
+}
+
 func (d *Device) RequiresMemoryMappingForAddressSpace(ctx context.Context, addressSpace sni.AddressSpace) (bool, error) {
 	if addressSpace == sni.AddressSpace_Raw {
 		return false, nil
 	}
-	if addressSpace == sni.AddressSpace_FxPakPro {
+	if addressSpace == sni.AddressSpace_FxPakPro || isPakOnlySpace(addressSpace) {
 		return false, nil
 	}
 	return true, nil
@@ -32,7 +79,7 @@ func (d *Device) RequiresMemoryMappingForAddress(ctx context.Context, address de
 	if address.AddressSpace == sni.AddressSpace_Raw {
 		return false, nil
 	}
-	if address.AddressSpace == sni.AddressSpace_FxPakPro {
+	if address.AddressSpace == sni.AddressSpace_FxPakPro || isPakOnlySpace(address.AddressSpace) {
 		return false, nil
 	}
 	return true, nil
@@ -56,7 +103,7 @@ func (d *Device) MultiReadMemory(
 			RequestAddress: read.RequestAddress,
 			DeviceAddress: devices.AddressTuple{
 				Address:       0,
-				AddressSpace:  sni.AddressSpace_FxPakPro,
+				AddressSpace:  deviceSpaceFor(read.RequestAddress.AddressSpace),
 				MemoryMapping: read.RequestAddress.MemoryMapping,
 			},
 			Data: make([]byte, read.Size),
@@ -64,7 +111,7 @@ func (d *Device) MultiReadMemory(
 
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
 			read.RequestAddress,
-			sni.AddressSpace_FxPakPro,
+			mrsp[j].DeviceAddress.AddressSpace,
 		)
 		if err != nil {
 			return nil, err
@@ -80,15 +127,24 @@ func (d *Device) MultiReadMemory(
 
 	// Break up larger reads (> 255 bytes) into 255-byte chunks:
 	for j, request := range reads {
-		startAddr := mrsp[j].DeviceAddress.Address
-
 		// determine the pak Space to read from:
-		pakSpace := SpaceSNES
-		space := spaceSNES
-		if startAddr>>24 == 0x01 {
-			pakSpace = SpaceCMD
-			space = spaceCMD
-			startAddr &= 0x00_FFFFFF
+		var sub subspace
+		var pakSpace space
+		var startAddr uint32
+		sub, pakSpace, startAddr, err = splitSubspace(mrsp[j].DeviceAddress)
+		if err != nil {
+			return
+		}
+
+		// VGET only reaches SNES and CMD spaces; MSU and CONFIG are read with GET:
+		if sub == spaceMSU || sub == spaceCONFIG {
+			var data []byte
+			data, err = d.get(subctx, pakSpace, startAddr, uint32(request.Size))
+			if err != nil {
+				return
+			}
+			copy(mrsp[j].Data, data)
+			continue
 		}
 
 		addr := startAddr
@@ -101,20 +157,20 @@ func (d *Device) MultiReadMemory(
 			}
 
 			// 4-byte struct: 1 byte size, 3 byte address
-			chunks[space] = append(chunks[space], vgetChunk{
+			chunks[sub] = append(chunks[sub], vgetChunk{
 				target: mrsp[j].Data[int(addr-startAddr):],
 				size:   byte(chunkSize),
 				addr:   addr,
 			})
 
-			if len(chunks[space]) == 8 {
-				err = d.vget(subctx, pakSpace, chunks[space]...)
+			if len(chunks[sub]) == 8 {
+				err = d.vget(subctx, pakSpace, chunks[sub]...)
 				if err != nil {
 					return
 				}
 
 				// reset chunks:
-				chunks[space] = chunks[space][0:0]
+				chunks[sub] = chunks[sub][0:0]
 			}
 
 			size -= 255
@@ -157,7 +213,7 @@ func (d *Device) MultiWriteMemory(
 			RequestAddress: write.RequestAddress,
 			DeviceAddress: devices.AddressTuple{
 				Address:       0,
-				AddressSpace:  sni.AddressSpace_FxPakPro,
+				AddressSpace:  deviceSpaceFor(write.RequestAddress.AddressSpace),
 				MemoryMapping: write.RequestAddress.MemoryMapping,
 			},
 			Size: len(write.Data),
@@ -165,7 +221,7 @@ func (d *Device) MultiWriteMemory(
 
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
 			write.RequestAddress,
-			sni.AddressSpace_FxPakPro,
+			mrsp[j].DeviceAddress.AddressSpace,
 		)
 		if err != nil {
 			return nil, err
@@ -180,28 +236,45 @@ func (d *Device) MultiWriteMemory(
 		subctx = context.WithValue(ctx, lockedKey, &struct{}{})
 	}
 
-	// pick out WRAM writes:
-	wramWrites := make([]devices.MemoryWriteRequest, 0, len(writes))
+	// pick out WRAM, VRAM, CGRAM and OAM writes:
+	nmiWrites := make([]devices.MemoryWriteRequest, 0, len(writes))
 
 	// Break up larger writes (> 255 bytes) into 255-byte chunks:
 	for j, request := range writes {
 		startAddr := mrsp[j].DeviceAddress.Address
 
-		// separate out WRAM writes to be handled specially:
-		if startAddr >= 0xF50000 && startAddr < 0xF70000 {
-			wramWrites = append(wramWrites, devices.MemoryWriteRequest{
+		// separate out writes that must be done by the SNES during NMI:
+		if mrsp[j].DeviceAddress.AddressSpace == sni.AddressSpace_FxPakPro && isNMIWrite(startAddr) {
+			if len(request.Data) == 0 {
+				// nothing to write; a DMA size or MVN count of 0 would copy 64KiB instead:
+				continue
+			}
+			err = checkNMIWrite(startAddr, len(request.Data))
+			if err != nil {
+				err = devices.WithCode(codes.InvalidArgument, err)
+				return
+			}
+			nmiWrites = append(nmiWrites, devices.MemoryWriteRequest{
 				RequestAddress: mrsp[j].DeviceAddress,
 				Data:           request.Data,
 			})
 			continue
 		}
 
-		pakSpace := SpaceSNES
-		space := spaceSNES
-		if startAddr>>24 == 0x01 {
-			pakSpace = SpaceCMD
-			space = spaceCMD
-			startAddr &= 0x00_FFFFFF
+		var sub subspace
+		var pakSpace space
+		sub, pakSpace, startAddr, err = splitSubspace(mrsp[j].DeviceAddress)
+		if err != nil {
+			return
+		}
+
+		// VPUT only reaches SNES and CMD spaces; MSU and CONFIG are written with PUT:
+		if sub == spaceMSU || sub == spaceCONFIG {
+			err = d.put(subctx, pakSpace, startAddr, request.Data)
+			if err != nil {
+				return
+			}
+			continue
 		}
 
 		addr := startAddr
@@ -214,19 +287,19 @@ func (d *Device) MultiWriteMemory(
 			}
 
 			// 4-byte struct: 1 byte size, 3 byte address
-			chunks[space] = append(chunks[space], vputChunk{
+			chunks[sub] = append(chunks[sub], vputChunk{
 				addr: addr,
 				// target offset to write to in Data[] for MemoryWriteResponse:
 				data: request.Data[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
 			})
 
-			if len(chunks[space]) == 8 {
-				err = d.vput(subctx, pakSpace, chunks[space]...)
+			if len(chunks[sub]) == 8 {
+				err = d.vput(subctx, pakSpace, chunks[sub]...)
 				if err != nil {
 					return
 				}
 				// reset chunks:
-				chunks[space] = chunks[space][0:0]
+				chunks[sub] = chunks[sub][0:0]
 			}
 
 			size -= 255
@@ -248,45 +321,30 @@ func (d *Device) MultiWriteMemory(
 		}
 	}
 
-	// handle WRAM writes using USB EXE feature of fxpakpro:
-	if len(wramWrites) > 0 {
+	// handle WRAM, VRAM, CGRAM and OAM writes using USB EXE feature of fxpakpro:
+	if len(nmiWrites) > 0 {
 		code := [512]byte{}
 		a := asm.NewEmitter(code[:], true)
 
-		// generate a copy routine to write data into WRAM:
-		GenerateCopyAsm(a, wramWrites...)
-
-		//a.WriteTextTo(log.Writer())
-
-		if actual, expected := a.Len(), 512; actual > expected {
+		if actual, expected := copyAsmSize(nmiWrites), len(code); actual > expected {
 			return nil, fmt.Errorf(
-				"fxpakpro: too much WRAM data for the snescmd buffer; %d > %d",
+				"fxpakpro: too much WRAM, VRAM, CGRAM and OAM data for the snescmd buffer; %d > %d",
 				actual,
 				expected,
 			)
 		}
 
-		chunks := make([]vputChunk, 0, 8)
-		startAddr := uint32(0x2C00)
-		addr := startAddr
-		data := code[:a.Len()]
-		size := len(data)
-		for size > 0 {
-			chunkSize := 255
-			if size < chunkSize {
-				chunkSize = size
-			}
+		// generate a copy routine to write data into WRAM and the PPU:
+		GenerateCopyAsm(a, nmiWrites...)
 
-			// 4-byte struct: 1 byte size, 3 byte address
-			chunks = append(chunks, vputChunk{
-				addr: addr,
-				// target offset to write to in Data[] for MemoryWriteResponse:
-				data: data[int(addr-startAddr) : int(addr-startAddr)+chunkSize],
-			})
+		//a.WriteTextTo(log.Writer())
 
-			size -= 255
-			addr += 255
-		}
+		// write everything but the first byte of the routine, then write the first byte last so the hook cannot run a
+		// partially written routine:
+		data := code[:a.Len()]
+		chunks := make([]vputChunk, 0, 8)
+		chunks = appendVputChunks(chunks, 0x2C01, data[1:])
+		chunks = append(chunks, vputChunk{addr: 0x2C00, data: data[0:1]})
 
 		if actual, expected := len(chunks), 8; actual > expected {
 			return nil, fmt.Errorf(
@@ -365,10 +423,112 @@ func (d *Device) awaitUSBEXE(ctx context.Context) (ok bool, err error) {
 	return
 }
 
+// FxPakPro address ranges of memory that can only be written to by the SNES itself during NMI:
+const (
+	wramStart  = 0xF50000
+	wramEnd    = 0xF70000
+	vramStart  = 0xF70000
+	vramEnd    = 0xF80000
+	cgramStart = 0xF90000
+	cgramEnd   = 0xF90200
+	oamStart   = 0xF90200
+	oamEnd     = 0xF90420
+)
+
+// isNMIWrite determines if the write must be done by code running during NMI, i.e. it targets WRAM, VRAM, CGRAM or
+// OAM:
+func isNMIWrite(addr uint32) bool {
+	return (addr >= wramStart && addr < vramEnd) || (addr >= cgramStart && addr < oamEnd)
+}
+
+// isPPUWrite determines if the write targets VRAM, CGRAM or OAM and must be done with DMA:
+func isPPUWrite(addr uint32) bool {
+	return (addr >= vramStart && addr < vramEnd) || (addr >= cgramStart && addr < oamEnd)
+}
+
+// checkNMIWrite validates that the write fits within its memory region and, for CGRAM and OAM which are written in
+// 16-bit words, is word-aligned:
+func checkNMIWrite(addr uint32, size int) (err error) {
+	end := addr + uint32(size)
+	switch {
+	case addr >= wramStart && addr < wramEnd:
+		if end > wramEnd {
+			err = fmt.Errorf("fxpakpro: WRAM write at $%06x of %d bytes crosses the end of WRAM", addr, size)
+		}
+	case addr >= vramStart && addr < vramEnd:
+		if end > vramEnd {
+			err = fmt.Errorf("fxpakpro: VRAM write at $%06x of %d bytes crosses the end of VRAM", addr, size)
+		}
+	case addr >= cgramStart && addr < cgramEnd:
+		if end > cgramEnd {
+			err = fmt.Errorf("fxpakpro: CGRAM write at $%06x of %d bytes crosses the end of CGRAM", addr, size)
+		} else if addr&1 != 0 || size&1 != 0 {
+			err = fmt.Errorf("fxpakpro: CGRAM write at $%06x of %d bytes is not word-aligned", addr, size)
+		}
+	case addr >= oamStart && addr < oamEnd:
+		if end > oamEnd {
+			err = fmt.Errorf("fxpakpro: OAM write at $%06x of %d bytes crosses the end of OAM", addr, size)
+		} else if addr&1 != 0 || size&1 != 0 {
+			err = fmt.Errorf("fxpakpro: OAM write at $%06x of %d bytes is not word-aligned", addr, size)
+		}
+	}
+	return
+}
+
+// GenerateCopyAsm emits a routine for the USB EXE hook at $2C00 that copies the data of the writes, which follows the
+// routine, to WRAM with MVN and to VRAM, CGRAM and OAM with DMA on channel 7.
 func GenerateCopyAsm(a *asm.Emitter, writes ...devices.MemoryWriteRequest) {
-	// codeSize represents the total size of ASM code below without MVN blocks:
-	const codeSize = 0x1B
+	ordered := orderCopyWrites(writes)
+
+	// emit the code once to learn its size so the data following it can be addressed:
+	codeSize := copyAsmSize(ordered) - dataSize(ordered)
+
+	generateCopyCode(a, uint16(0x2C00+codeSize), ordered)
+
+	// bug check: make sure emitted code is the expected size
+	if actual, expected := a.Len(), codeSize; actual != expected {
+		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+	}
+
+	// copy in the data to be written:
+	for _, write := range ordered {
+		a.EmitBytes(write.Data)
+	}
+}
 
+// orderCopyWrites puts the DMA transfers first so they run while the data bank register is still $00 since MVN
+// changes it. Empty writes are dropped since a DMA size of 0 transfers 64KiB and an MVN count of 0 becomes $FFFF:
+func orderCopyWrites(writes []devices.MemoryWriteRequest) (ordered []devices.MemoryWriteRequest) {
+	ordered = make([]devices.MemoryWriteRequest, 0, len(writes))
+	for _, write := range writes {
+		if len(write.Data) > 0 && isPPUWrite(write.RequestAddress.Address) {
+			ordered = append(ordered, write)
+		}
+	}
+	for _, write := range writes {
+		if len(write.Data) > 0 && !isPPUWrite(write.RequestAddress.Address) {
+			ordered = append(ordered, write)
+		}
+	}
+	return
+}
+
+// copyAsmSize returns the size of the routine GenerateCopyAsm emits for the writes including their data:
+func copyAsmSize(writes []devices.MemoryWriteRequest) int {
+	ordered := orderCopyWrites(writes)
+	measure := asm.NewEmitter(make([]byte, 0x10000), false)
+	generateCopyCode(measure, 0, ordered)
+	return measure.Len() + dataSize(ordered)
+}
+
+func dataSize(writes []devices.MemoryWriteRequest) (size int) {
+	for _, write := range writes {
+		size += len(write.Data)
+	}
+	return
+}
+
+func generateCopyCode(a *asm.Emitter, srcOffs uint16, writes []devices.MemoryWriteRequest) {
 	a.SetBase(0x002C00)
 
 	// this NOP slide is necessary to avoid the problematic $2C00 address itself.
@@ -385,26 +545,84 @@ func GenerateCopyAsm(a *asm.Emitter, writes ...devices.MemoryWriteRequest) {
 
 	// MVN affects B register:
 	a.PHB()
-	expectedCodeSize := codeSize + (12 * len(writes))
-	srcOffs := uint16(0x2C00 + expectedCodeSize)
+
+	hasDMA := len(writes) > 0 && isPPUWrite(writes[0].RequestAddress.Address)
+	if hasDMA {
+		a.PHK()
+		a.PLB()
+
+		// the game may use channel 7 for HDMA:
+		a.Comment("preserve DMA channel 7 registers:")
+		for _, reg := range []uint16{0x4370, 0x4372, 0x4374, 0x4376} {
+			a.LDA_abs(reg)
+			a.PHA()
+		}
+		a.SEP(0x20)
+	}
+
 	for _, write := range writes {
 		data := write.Data
 		size := uint16(len(data))
 		targetFXPakProAddress := write.RequestAddress.Address
-		destBank := uint8(0x7E + (targetFXPakProAddress-0xF5_0000)>>16)
-		destOffs := uint16(targetFXPakProAddress & 0xFFFF)
-
-		a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to $%02x:%04x", size, srcOffs, destBank, destOffs))
-		// A - Specifies the amount of bytes to transfer, minus 1
-		a.LDA_imm16_w(size - 1)
-		// X - Specifies the high and low bytes of the data source memory address
-		a.LDX_imm16_w(srcOffs)
-		// Y - Specifies the high and low bytes of the destination memory address
-		a.LDY_imm16_w(destOffs)
-		a.MVN(destBank, 0x00)
+
+		switch {
+		case targetFXPakProAddress >= vramStart && targetFXPakProAddress < vramEnd:
+			offs := targetFXPakProAddress - vramStart
+			src := srcOffs
+			n := size
+			if offs&1 != 0 {
+				// write the high byte of the first word alone:
+				generateVRAMDMA(a, 0x80, offs, 0x19, 0x00, src, 1)
+				offs, src, n = offs+1, src+1, n-1
+			}
+			if words := n &^ 1; words > 0 {
+				generateVRAMDMA(a, 0x80, offs, 0x18, 0x01, src, words)
+				offs, src, n = offs+uint32(words), src+words, n-words
+			}
+			if n > 0 {
+				// write the low byte of the last word alone:
+				generateVRAMDMA(a, 0x00, offs, 0x18, 0x00, src, 1)
+			}
+
+		case targetFXPakProAddress >= cgramStart && targetFXPakProAddress < cgramEnd:
+			offs := targetFXPakProAddress - cgramStart
+			a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to CGRAM $%03x", size, srcOffs, offs))
+			a.LDA_imm8_b(uint8(offs >> 1))
+			a.STA_abs(0x2121)
+			generateDMA(a, 0x22, 0x00, srcOffs, size)
+
+		case targetFXPakProAddress >= oamStart && targetFXPakProAddress < oamEnd:
+			offs := targetFXPakProAddress - oamStart
+			a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to OAM $%03x", size, srcOffs, offs))
+			a.LDX_imm16_w(uint16(offs >> 1))
+			a.STX_abs(0x2102)
+			generateDMA(a, 0x04, 0x00, srcOffs, size)
+
+		default:
+			if hasDMA {
+				hasDMA = false
+				generateRestoreDMA(a)
+			}
+
+			destBank := uint8(0x7E + (targetFXPakProAddress-wramStart)>>16)
+			destOffs := uint16(targetFXPakProAddress & 0xFFFF)
+
+			a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to $%02x:%04x", size, srcOffs, destBank, destOffs))
+			// A - Specifies the amount of bytes to transfer, minus 1
+			a.LDA_imm16_w(size - 1)
+			// X - Specifies the high and low bytes of the data source memory address
+			a.LDX_imm16_w(srcOffs)
+			// Y - Specifies the high and low bytes of the destination memory address
+			a.LDY_imm16_w(destOffs)
+			a.MVN(destBank, 0x00)
+		}
 
 		srcOffs += size
 	}
+
+	if hasDMA {
+		generateRestoreDMA(a)
+	}
 	a.PLB()
 
 	a.Comment("disable NMI vector override:")
@@ -421,14 +639,98 @@ func GenerateCopyAsm(a *asm.Emitter, writes ...devices.MemoryWriteRequest) {
 
 	a.Comment("jump to original NMI:")
 	a.JMP_indirect(0xFFEA)
+}
 
-	// bug check: make sure emitted code is the expected size
-	if actual, expected := a.Len(), expectedCodeSize; actual != expected {
-		panic(fmt.Errorf("bug check: emitted code size %d != %d", actual, expected))
+// generateRestoreDMA restores the DMA channel 7 registers and switches back to 16-bit A:
+func generateRestoreDMA(a *asm.Emitter) {
+	a.REP(0x30)
+	a.Comment("restore DMA channel 7 registers:")
+	for _, reg := range []uint16{0x4376, 0x4374, 0x4372, 0x4370} {
+		a.PLA()
+		a.STA_abs(reg)
 	}
+}
 
-	// copy in the data to be written to WRAM:
-	for _, write := range writes {
-		a.EmitBytes(write.Data)
+// generateVRAMDMA sets the VRAM address to the word containing the byte offset offs and transfers size bytes to the
+// given VRAM data port:
+func generateVRAMDMA(a *asm.Emitter, vmain uint8, offs uint32, port uint8, mode uint8, src uint16, size uint16) {
+	a.Comment(fmt.Sprintf("transfer $%04x bytes from $00:%04x to VRAM $%04x", size, src, offs))
+	a.LDA_imm8_b(vmain)
+	a.STA_abs(0x2115)
+	a.LDX_imm16_w(uint16(offs >> 1))
+	a.STX_abs(0x2116)
+	generateDMA(a, port, mode, src, size)
+}
+
+// generateDMA transfers size bytes from $00:src to the B-bus port $21xx using DMA channel 7 with the given transfer
+// mode. Expects 8-bit A and 16-bit X:
+func generateDMA(a *asm.Emitter, port uint8, mode uint8, src uint16, size uint16) {
+	a.LDA_imm8_b(mode)
+	a.STA_abs(0x4370)
+	a.LDA_imm8_b(port)
+	a.STA_abs(0x4371)
+	a.LDX_imm16_w(src)
+	a.STX_abs(0x4372)
+	a.STZ_abs(0x4374)
+	a.LDX_imm16_w(size)
+	a.STX_abs(0x4375)
+	a.LDA_imm8_b(0x80)
+	a.STA_abs(0x420B)
+}
+
+// memoryRegions are the regions of the FX Pak Pro address spaces; APU, MISC and register contents are snapshots taken
+// by the FPGA and cannot be written to. CARTROM and SRAM have their maximum sizes here:
+var memoryRegions = []devices.MemoryRegion{
+	{Name: "CARTROM", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0x000000, Size: 0xE00000, Readable: true, Writable: true},
+	{Name: "SRAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0xE00000, Size: 0x100000, Readable: true, Writable: true},
+	{Name: "WRAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: wramStart, Size: wramEnd - wramStart, Readable: true, Writable: true},
+	{Name: "VRAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: vramStart, Size: vramEnd - vramStart, Readable: true, Writable: true},
+	{Name: "APURAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0xF80000, Size: 0x10000, Readable: true},
+	{Name: "CGRAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: cgramStart, Size: cgramEnd - cgramStart, Readable: true, Writable: true},
+	{Name: "OAM", AddressSpace: sni.AddressSpace_FxPakPro, Address: oamStart, Size: oamEnd - oamStart, Readable: true, Writable: true},
+	{Name: "MISC", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0xF90420, Size: 0xE0, Readable: true},
+	{Name: "PPUREG", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0xF90500, Size: 0x200, Readable: true},
+	{Name: "CPUREG", AddressSpace: sni.AddressSpace_FxPakPro, Address: 0xF90700, Size: 0x200, Readable: true},
+	{Name: "MSU", AddressSpace: sni.AddressSpace_FxPakProMSU, Address: 0x000000, Size: 0x1000000, Readable: true, Writable: true},
+	{Name: "CONFIG", AddressSpace: sni.AddressSpace_FxPakProConfig, Address: 0x000000, Size: 0x1000000, Readable: true, Writable: true},
+}
+
+// ListMemoryRegions sizes CARTROM and SRAM by the header of the running ROM and leaves out SRAM if the ROM has none. In
+// the menu or without a recognizable header they keep their maximum sizes.
+func (d *Device) ListMemoryRegions(ctx context.Context) (regions []devices.MemoryRegion, err error) {
+	var inf deviceInfo
+	inf, err = d.info(ctx)
+	if err != nil {
+		return
+	}
+
+	var header *snes.Header
+	if !inf.inMenu() {
+		header, err = d.romHeader(ctx)
+		if err != nil {
+			return
+		}
 	}
+
+	regions = make([]devices.MemoryRegion, 0, len(memoryRegions))
+	for _, region := range memoryRegions {
+		if header != nil {
+			romSize, sramSize := mapping.Sizes(header)
+			switch region.Name {
+			case "CARTROM":
+				if romSize != 0 && romSize < region.Size {
+					region.Size = romSize
+				}
+			case "SRAM":
+				if sramSize == 0 {
+					continue
+				}
+				if sramSize < region.Size {
+					region.Size = sramSize
+				}
+			}
+		}
+		regions = append(regions, region)
+	}
+	return
 }
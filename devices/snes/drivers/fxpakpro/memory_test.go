@@ -33,6 +33,33 @@ func TestGenerateCopyAsm(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Mixed WRAM and PPU writes",
+			args: []devices.MemoryWriteRequest{
+				{
+					RequestAddress: devices.AddressTuple{
+						Address:       0xF50010,
+						AddressSpace:  sni.AddressSpace_FxPakPro,
+						MemoryMapping: sni.MemoryMapping_LoROM,
+					},
+					Data: []byte{0x07, 0x08, 0x09},
+				}, {
+					RequestAddress: devices.AddressTuple{
+						Address:       0xF70001,
+						AddressSpace:  sni.AddressSpace_FxPakPro,
+						MemoryMapping: sni.MemoryMapping_LoROM,
+					},
+					Data: []byte{0x17, 0x18, 0x19, 0x1A},
+				}, {
+					RequestAddress: devices.AddressTuple{
+						Address:       0xF90000,
+						AddressSpace:  sni.AddressSpace_FxPakPro,
+						MemoryMapping: sni.MemoryMapping_LoROM,
+					},
+					Data: []byte{0x27, 0x28},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -43,3 +70,17 @@ func TestGenerateCopyAsm(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateExecuteAsm(t *testing.T) {
+	code := [512]byte{}
+	a := asm.NewEmitter(code[:], true)
+	GenerateExecuteAsm(a, []byte{0x60}, []byte{0x01, 0x02})
+	a.WriteTextTo(log.Writer())
+
+	if actual, expected := a.Len(), exeHookSize+3; actual != expected {
+		t.Fatalf("emitted %d bytes, want %d", actual, expected)
+	}
+	if code[exeHookSize] != 0x60 {
+		t.Fatalf("code not placed at $%04x", exeCodeAddr)
+	}
+}
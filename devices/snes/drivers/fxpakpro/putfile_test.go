@@ -73,7 +73,7 @@ func TestDevice_putFile(t *testing.T) {
 			_ = n
 
 			// make sure a second command works immediately after transfer:
-			_, _, _, err = d.info(ctx)
+			_, err = d.info(ctx)
 			if err != nil {
 				t.Errorf("info() after putFile() failed: %v", err)
 			}
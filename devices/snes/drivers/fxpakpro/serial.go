@@ -57,6 +57,12 @@ func readExact(ctx context.Context, f io.Reader, chunkSize uint32, buf []byte) (
 		if err != nil {
 			return
 		}
+
+		// serial ports return no data and no error when the read timeout elapses:
+		if _, ok := f.(hasSetReadTimeout); ok && n == 0 && !time.Now().Before(deadline) {
+			err = context.DeadlineExceeded
+			return
+		}
 	}
 
 	return
@@ -147,6 +153,11 @@ func sendSerialProgress(f serial.Port, chunkSize uint32, size uint32, r io.Reade
 		sent += n
 	}
 
+	// do not count the padding of the final chunk:
+	if sent > size {
+		sent = size
+	}
+
 	// final progress report:
 	if report != nil {
 		report(sent, size)
@@ -0,0 +1,1009 @@
+package fxpakpro
+
+import (
+	"encoding/binary"
+	"errors"
+	"github.com/alttpo/snes/timing"
+	"go.bug.st/serial"
+	"io"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FatFs result codes reported by the firmware in the response packet:
+const (
+	simErrNoFile  = 4
+	simErrNoPath  = 5
+	simErrDenied  = 7
+	simErrExist   = 8
+	simErrInvalid = 9
+)
+
+// Simulator is an in-process simulation of the sd2snes USB protocol backed by an in-memory SD card and SNES memory.
+// Ports opened from it satisfy serial.Port so the driver talks to it exactly as it would to a real cart. The SD card
+// and memory contents outlive any port opened from the Simulator.
+type Simulator struct {
+	lock sync.Mutex
+
+	// SD card contents keyed by path without leading slash; "" is the root directory:
+	files map[string][]byte
+	dirs  map[string]struct{}
+
+	// memory of each space:
+	snes   []byte
+	cmd    []byte
+	msu    []byte
+	config []byte
+
+	rom string
+
+	// PPU and DMA registers written by code running during NMI; VRAM, CGRAM and OAM writes land in their snes space
+	// mirrors:
+	io        [0x4400]byte
+	vramAddr  uint16
+	cgramAddr uint16
+	oamAddr   uint16
+
+	// Features is reported in the INFO reply:
+	Features info_flags
+	// Version and DeviceName are reported in the INFO reply:
+	Version    string
+	DeviceName string
+	// NoStream simulates older firmware that ignores the STREAM opcode:
+	NoStream bool
+	// StreamReplyDelay delays the reply to STREAM, e.g. for a busy firmware:
+	StreamReplyDelay time.Duration
+	// Paused simulates a paused console or one in the menu which runs no NMI so STREAM pushes no frames:
+	Paused bool
+}
+
+func NewSimulator() *Simulator {
+	return &Simulator{
+		files:      make(map[string][]byte),
+		dirs:       map[string]struct{}{"": {}},
+		snes:       make([]byte, 0x1000000),
+		cmd:        make([]byte, 0x10000),
+		msu:        make([]byte, 0x1000000),
+		config:     make([]byte, 0x10000),
+		Features:   FeatMSU1 | FeatCMD_UNLOCK | FeatUSB1 | FeatDMA1,
+		Version:    "1.11.0-usb-v11",
+		DeviceName: "FXPAK PRO STM32",
+	}
+}
+
+var (
+	simulatorsLock sync.Mutex
+	simulators     = make(map[string]*Simulator)
+)
+
+// Simulated returns the named Simulator used for `fxpakpro://sim/<name>` URIs, creating it if necessary
+func Simulated(name string) *Simulator {
+	name = strings.Trim(name, "/")
+
+	defer simulatorsLock.Unlock()
+	simulatorsLock.Lock()
+
+	s, ok := simulators[name]
+	if !ok {
+		s = NewSimulator()
+		simulators[name] = s
+	}
+	return s
+}
+
+func simPath(p string) string {
+	p = path.Clean("/" + p)
+	return strings.TrimPrefix(p, "/")
+}
+
+// WriteFile places a file on the simulated SD card, creating its parent directories
+func (s *Simulator) WriteFile(name string, data []byte) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	name = simPath(name)
+	for dir := path.Dir(name); dir != "." && dir != ""; dir = path.Dir(dir) {
+		s.dirs[dir] = struct{}{}
+	}
+	s.files[name] = append([]byte(nil), data...)
+}
+
+// ReadFile returns a copy of a file on the simulated SD card
+func (s *Simulator) ReadFile(name string) (data []byte, ok bool) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	data, ok = s.files[simPath(name)]
+	data = append([]byte(nil), data...)
+	return
+}
+
+// Open returns a new serial.Port connected to the Simulator
+func (s *Simulator) Open() serial.Port {
+	p := &simPort{
+		sim:         s,
+		in:          newSimPipe(),
+		out:         newSimPipe(),
+		readTimeout: serial.NoTimeout,
+		closed:      make(chan struct{}),
+	}
+	go p.serve()
+	go p.nmi()
+	return p
+}
+
+func (s *Simulator) memory(sp space) []byte {
+	switch sp {
+	case SpaceSNES:
+		return s.snes
+	case SpaceCMD:
+		return s.cmd
+	case SpaceMSU:
+		return s.msu
+	case SpaceCONFIG:
+		return s.config
+	default:
+		return nil
+	}
+}
+
+// simPipe is a one-directional byte stream with timeout-aware reads:
+type simPipe struct {
+	lock   sync.Mutex
+	buf    []byte
+	ready  chan struct{}
+	closed bool
+}
+
+func newSimPipe() *simPipe {
+	return &simPipe{ready: make(chan struct{}, 1)}
+}
+
+func (p *simPipe) notify() {
+	select {
+	case p.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (p *simPipe) write(b []byte) {
+	p.lock.Lock()
+	p.buf = append(p.buf, b...)
+	p.lock.Unlock()
+	p.notify()
+}
+
+func (p *simPipe) close() {
+	p.lock.Lock()
+	p.closed = true
+	p.lock.Unlock()
+	p.notify()
+}
+
+func (p *simPipe) reset() {
+	p.lock.Lock()
+	p.buf = nil
+	p.lock.Unlock()
+}
+
+// wait waits up to timeout (or forever if negative) for data to become available
+func (p *simPipe) wait(timeout time.Duration) (ok bool, err error) {
+	var expired <-chan time.Time
+	if timeout >= 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		p.lock.Lock()
+		n, closed := len(p.buf), p.closed
+		p.lock.Unlock()
+		if n > 0 {
+			return true, nil
+		}
+		if closed {
+			return false, io.EOF
+		}
+
+		select {
+		case <-p.ready:
+		case <-expired:
+			return false, nil
+		}
+	}
+}
+
+// read behaves like serial.Port's Read: it returns 0 bytes and no error when the timeout elapses
+func (p *simPipe) read(b []byte, timeout time.Duration) (n int, err error) {
+	var ok bool
+	ok, err = p.wait(timeout)
+	if !ok {
+		return
+	}
+
+	p.lock.Lock()
+	n = copy(b, p.buf)
+	p.buf = p.buf[n:]
+	p.lock.Unlock()
+	return
+}
+
+func (p *simPipe) readFull(b []byte) (err error) {
+	for len(b) > 0 {
+		var n int
+		n, err = p.read(b, serial.NoTimeout)
+		if err != nil {
+			return
+		}
+		b = b[n:]
+	}
+	return
+}
+
+type simPort struct {
+	sim *Simulator
+
+	// in carries commands from the driver to the simulated firmware; out carries the replies:
+	in  *simPipe
+	out *simPipe
+
+	timeoutLock sync.Mutex
+	readTimeout time.Duration
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+var errSimPortClosed = errors.New("simulator: port closed")
+
+func (p *simPort) SetMode(mode *serial.Mode) error { return nil }
+
+func (p *simPort) Read(b []byte) (n int, err error) {
+	p.timeoutLock.Lock()
+	timeout := p.readTimeout
+	p.timeoutLock.Unlock()
+
+	n, err = p.out.read(b, timeout)
+	if err == io.EOF {
+		err = errSimPortClosed
+	}
+	return
+}
+
+func (p *simPort) Write(b []byte) (n int, err error) {
+	select {
+	case <-p.closed:
+		return 0, errSimPortClosed
+	default:
+	}
+
+	p.in.write(b)
+	return len(b), nil
+}
+
+func (p *simPort) ResetInputBuffer() error {
+	p.out.reset()
+	return nil
+}
+
+func (p *simPort) ResetOutputBuffer() error { return nil }
+
+func (p *simPort) SetDTR(dtr bool) error { return nil }
+
+func (p *simPort) SetRTS(rts bool) error { return nil }
+
+func (p *simPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
+
+func (p *simPort) SetReadTimeout(t time.Duration) error {
+	p.timeoutLock.Lock()
+	p.readTimeout = t
+	p.timeoutLock.Unlock()
+	return nil
+}
+
+func (p *simPort) Close() error {
+	p.closeOnce.Do(func() {
+		close(p.closed)
+		p.in.close()
+		p.out.close()
+	})
+	return nil
+}
+
+func (p *simPort) Break(time.Duration) error { return nil }
+
+// respond sends a response packet of the given size (64 or 512) with the error code and size fields filled in:
+func (p *simPort) respond(n int, ec byte, size uint32) {
+	sb := make([]byte, n)
+	copy(sb, "USBA")
+	sb[4] = byte(OpRESPONSE)
+	sb[5] = ec
+	if n >= 256 {
+		binary.BigEndian.PutUint32(sb[252:], size)
+	}
+	p.out.write(sb)
+}
+
+// send sends data padded with zeros to a multiple of the block size:
+func (p *simPort) send(data []byte, block int) {
+	padded := make([]byte, (len(data)+block-1)/block*block)
+	copy(padded, data)
+	p.out.write(padded)
+}
+
+// recv receives size bytes of data sent in blocks of the given size:
+func (p *simPort) recv(size int, block int) (data []byte, err error) {
+	data = make([]byte, (size+block-1)/block*block)
+	err = p.in.readFull(data)
+	data = data[:size]
+	return
+}
+
+// serve runs the simulated firmware's command loop until the port is closed:
+func (p *simPort) serve() {
+	sb := make([]byte, 512)
+	for {
+		if err := p.in.readFull(sb[:64]); err != nil {
+			return
+		}
+		n := 64
+		if server_flags(sb[6])&FlagDATA64B == 0 {
+			if err := p.in.readFull(sb[64:512]); err != nil {
+				return
+			}
+			n = 512
+		}
+		if string(sb[0:4]) != "USBA" {
+			// real firmware ignores anything without a valid header:
+			continue
+		}
+
+		if err := p.handle(opcode(sb[4]), space(sb[5]), sb[:n]); err != nil {
+			return
+		}
+	}
+}
+
+func cstring(b []byte) string {
+	return string(b[:clen(b)])
+}
+
+func (p *simPort) handle(op opcode, sp space, sb []byte) (err error) {
+	s := p.sim
+	switch op {
+	case OpGET:
+		if sp == SpaceFILE {
+			name := simPath(cstring(sb[256:]))
+			s.lock.Lock()
+			data, ok := s.files[name]
+			s.lock.Unlock()
+			if !ok {
+				p.respond(512, simErrNoFile, 0)
+				return
+			}
+			p.respond(512, 0, uint32(len(data)))
+			p.send(data, 512)
+			return
+		}
+
+		size := binary.BigEndian.Uint32(sb[252:])
+		addr := binary.BigEndian.Uint32(sb[256:])
+		s.lock.Lock()
+		mem := s.memory(sp)
+		if uint64(addr)+uint64(size) > uint64(len(mem)) {
+			s.lock.Unlock()
+			p.respond(512, simErrInvalid, 0)
+			return
+		}
+		data := append([]byte(nil), mem[addr:addr+size]...)
+		s.lock.Unlock()
+		p.respond(512, 0, size)
+		p.send(data, 512)
+		return
+
+	case OpPUT:
+		size := binary.BigEndian.Uint32(sb[252:])
+		if sp == SpaceFILE {
+			name := simPath(cstring(sb[256:]))
+			s.lock.Lock()
+			_, dirOk := s.dirs[path.Dir(name)]
+			if path.Dir(name) == "." {
+				dirOk = true
+			}
+			s.lock.Unlock()
+			if !dirOk {
+				p.respond(512, simErrNoPath, 0)
+				return
+			}
+			p.respond(512, 0, size)
+
+			// an empty file is still followed by one block:
+			blocks := int(size)
+			if blocks == 0 {
+				blocks = 1
+			}
+			var data []byte
+			data, err = p.recv(blocks, 512)
+			if err != nil {
+				return
+			}
+
+			s.lock.Lock()
+			s.files[name] = data[:size]
+			s.lock.Unlock()
+			return
+		}
+
+		addr := binary.BigEndian.Uint32(sb[256:])
+		var data []byte
+		data, err = p.recv(int(size), 512)
+		if err != nil {
+			return
+		}
+		s.lock.Lock()
+		mem := s.memory(sp)
+		if uint64(addr)+uint64(size) > uint64(len(mem)) {
+			s.lock.Unlock()
+			p.respond(512, simErrInvalid, 0)
+			return
+		}
+		copy(mem[addr:], data)
+		s.lock.Unlock()
+		p.respond(512, 0, size)
+		return
+
+	case OpVGET:
+		chunks := simChunks(sb)
+		total := 0
+		for _, c := range chunks {
+			total += int(c.size)
+		}
+		data := make([]byte, 0, total)
+		s.lock.Lock()
+		mem := s.memory(sp)
+		for _, c := range chunks {
+			data = append(data, simRead(mem, c.addr, int(c.size))...)
+		}
+		s.lock.Unlock()
+		p.send(data, 64)
+		return
+
+	case OpVPUT:
+		chunks := simChunks(sb)
+		total := 0
+		for _, c := range chunks {
+			total += int(c.size)
+		}
+		var data []byte
+		data, err = p.recv(total, 64)
+		if err != nil {
+			return
+		}
+		s.lock.Lock()
+		mem := s.memory(sp)
+		for _, c := range chunks {
+			if int(c.addr)+int(c.size) <= len(mem) {
+				copy(mem[c.addr:], data[:c.size])
+			}
+			data = data[c.size:]
+		}
+		s.lock.Unlock()
+		return
+
+	case OpLS:
+		dir := simPath(cstring(sb[256:]))
+		s.lock.Lock()
+		_, ok := s.dirs[dir]
+		entries := s.list(dir)
+		s.lock.Unlock()
+		if !ok {
+			p.respond(512, simErrNoPath, 0)
+			return
+		}
+		p.respond(512, 0, 1)
+		p.sendList(entries)
+		return
+
+	case OpMKDIR:
+		name := simPath(cstring(sb[256:]))
+		p.respond(512, s.mkdir(name), 0)
+		return
+
+	case OpRM:
+		name := simPath(cstring(sb[256:]))
+		p.respond(512, s.rm(name), 0)
+		return
+
+	case OpMV:
+		name := simPath(cstring(sb[256:]))
+		newName := cstring(sb[8:256])
+		p.respond(512, s.mv(name, newName), 0)
+		return
+
+	case OpBOOT:
+		name := simPath(cstring(sb[256:]))
+		s.lock.Lock()
+		data, ok := s.files[name]
+		if ok {
+			// load the ROM contents and clear SRAM and WRAM:
+			for i := range s.snes {
+				s.snes[i] = 0
+			}
+			copy(s.snes[:0xE00000], data)
+			s.rom = "/" + name
+		}
+		s.lock.Unlock()
+		if !ok {
+			p.respond(512, simErrNoFile, 0)
+			return
+		}
+		p.respond(512, 0, 0)
+		return
+
+	case OpMENU_RESET:
+		s.lock.Lock()
+		s.rom = "/sd2snes/m3nu.bin"
+		s.lock.Unlock()
+		p.respond(512, 0, 0)
+		return
+
+	case OpINFO:
+		rsp := make([]byte, 512)
+		copy(rsp, "USBA")
+		rsp[4] = byte(OpRESPONSE)
+		s.lock.Lock()
+		rsp[6] = byte(s.Features)
+		copy(rsp[16:252], s.rom)
+		copy(rsp[260:260+64], s.Version)
+		copy(rsp[260+64:260+128], s.DeviceName)
+		s.lock.Unlock()
+		p.out.write(rsp)
+		return
+
+	case OpSTREAM:
+		s.lock.Lock()
+		noStream := s.NoStream
+		s.lock.Unlock()
+		if noStream {
+			return
+		}
+		return p.stream(sp, simChunks(sb))
+
+	case OpRESET, OpPOWER_CYCLE, OpTIME:
+		p.respond(len(sb), 0, 0)
+		return
+
+	default:
+		p.respond(len(sb), simErrInvalid, 0)
+		return
+	}
+}
+
+type simChunk struct {
+	size uint8
+	addr uint32
+}
+
+// simChunks parses the VGET/VPUT chunk table:
+func simChunks(sb []byte) (chunks []simChunk) {
+	for i := 32; i+4 <= 64; i += 4 {
+		c := simChunk{
+			size: sb[i],
+			addr: uint32(sb[i+1])<<16 | uint32(sb[i+2])<<8 | uint32(sb[i+3]),
+		}
+		if c.size == 0 {
+			continue
+		}
+		chunks = append(chunks, c)
+	}
+	return
+}
+
+func simRead(mem []byte, addr uint32, size int) []byte {
+	data := make([]byte, size)
+	if int(addr) < len(mem) {
+		copy(data, mem[addr:])
+	}
+	return data
+}
+
+type simEntry struct {
+	name  string
+	isDir bool
+}
+
+func (s *Simulator) list(dir string) (entries []simEntry) {
+	isChild := func(name string) bool {
+		parent := path.Dir(name)
+		if parent == "." {
+			parent = ""
+		}
+		return name != "" && parent == dir
+	}
+	for name := range s.dirs {
+		if isChild(name) {
+			entries = append(entries, simEntry{name: path.Base(name), isDir: true})
+		}
+	}
+	for name := range s.files {
+		if isChild(name) {
+			entries = append(entries, simEntry{name: path.Base(name)})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].name < entries[j].name })
+	return
+}
+
+// sendList sends LS entries as 512-byte packets; $02 continues in the next packet and $FF ends the list:
+func (p *simPort) sendList(entries []simEntry) {
+	pkt := make([]byte, 0, 512)
+	for _, e := range entries {
+		rec := make([]byte, 0, len(e.name)+2)
+		if e.isDir {
+			rec = append(rec, 0)
+		} else {
+			rec = append(rec, 1)
+		}
+		rec = append(rec, e.name...)
+		rec = append(rec, 0)
+
+		// leave room for the terminating marker:
+		if len(pkt)+len(rec)+1 > 512 {
+			pkt = append(pkt, 2)
+			p.send(pkt, 512)
+			pkt = pkt[:0]
+		}
+		pkt = append(pkt, rec...)
+	}
+	pkt = append(pkt, 0xFF)
+	p.send(pkt, 512)
+}
+
+func (s *Simulator) mkdir(name string) byte {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	parent := path.Dir(name)
+	if parent == "." {
+		parent = ""
+	}
+	if _, ok := s.dirs[parent]; !ok {
+		return simErrNoPath
+	}
+	if _, ok := s.dirs[name]; ok {
+		return simErrExist
+	}
+	if _, ok := s.files[name]; ok {
+		return simErrExist
+	}
+	s.dirs[name] = struct{}{}
+	return 0
+}
+
+func (s *Simulator) rm(name string) byte {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	if _, ok := s.files[name]; ok {
+		delete(s.files, name)
+		return 0
+	}
+	if _, ok := s.dirs[name]; !ok || name == "" {
+		return simErrNoFile
+	}
+	if len(s.list(name)) > 0 {
+		return simErrDenied
+	}
+	delete(s.dirs, name)
+	return 0
+}
+
+func (s *Simulator) mv(name string, newName string) byte {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+
+	if strings.ContainsRune(newName, '/') || newName == "" {
+		return simErrInvalid
+	}
+	target := path.Join(path.Dir(name), newName)
+	if path.Dir(name) == "." {
+		target = newName
+	}
+	if _, ok := s.files[target]; ok {
+		return simErrExist
+	}
+	if _, ok := s.dirs[target]; ok {
+		return simErrExist
+	}
+
+	if data, ok := s.files[name]; ok {
+		delete(s.files, name)
+		s.files[target] = data
+		return 0
+	}
+	if _, ok := s.dirs[name]; ok && name != "" {
+		// move the directory and everything under it:
+		dirs := make([]string, 0, len(s.dirs))
+		for k := range s.dirs {
+			if k == name || strings.HasPrefix(k, name+"/") {
+				dirs = append(dirs, k)
+			}
+		}
+		for _, k := range dirs {
+			delete(s.dirs, k)
+			s.dirs[target+k[len(name):]] = struct{}{}
+		}
+		files := make([]string, 0, len(s.files))
+		for k := range s.files {
+			if strings.HasPrefix(k, name+"/") {
+				files = append(files, k)
+			}
+		}
+		for _, k := range files {
+			s.files[target+k[len(name):]] = s.files[k]
+			delete(s.files, k)
+		}
+		return 0
+	}
+	return simErrNoFile
+}
+
+// stream pushes a frame of the chunks' data every SNES frame until an empty STREAM command ends it:
+func (p *simPort) stream(sp space, chunks []simChunk) (err error) {
+	p.sim.lock.Lock()
+	delay := p.sim.StreamReplyDelay
+	p.sim.lock.Unlock()
+	time.Sleep(delay)
+	p.respond(64, 0, 0)
+
+	sb := make([]byte, 64)
+	for {
+		var ok bool
+		ok, err = p.in.wait(timing.Frame)
+		if err != nil {
+			return
+		}
+
+		if !ok {
+			data := make([]byte, 0, 8*255)
+			p.sim.lock.Lock()
+			if p.sim.Paused {
+				p.sim.lock.Unlock()
+				continue
+			}
+			mem := p.sim.memory(sp)
+			for _, c := range chunks {
+				data = append(data, simRead(mem, c.addr, int(c.size))...)
+			}
+			p.sim.lock.Unlock()
+			p.send(data, 64)
+			continue
+		}
+
+		err = p.in.readFull(sb)
+		if err != nil {
+			return
+		}
+		if string(sb[0:4]) == "USBA" && opcode(sb[4]) == OpSTREAM && len(simChunks(sb)) == 0 {
+			p.respond(64, 0, 0)
+			return
+		}
+	}
+}
+
+// nmi simulates the SNES NMI once per frame and runs code installed at the USB EXE hook at $2C00 in CMD space:
+func (p *simPort) nmi() {
+	ticker := time.NewTicker(timing.Frame)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.closed:
+			return
+		case <-ticker.C:
+		}
+
+		s := p.sim
+		s.lock.Lock()
+		if s.cmd[0x2C00] != 0 {
+			s.exec(0x2C00)
+			// guarantee the hook is released even if the code was not understood:
+			s.cmd[0x2C00] = 0
+		}
+		s.lock.Unlock()
+	}
+}
+
+// bus maps a SNES A-bus address to simulated memory; ok is false for unmapped addresses:
+func (s *Simulator) bus(addr uint32) (mem []byte, offs uint32, ok bool) {
+	bank, lo := addr>>16, addr&0xFFFF
+	switch {
+	case bank == 0x7E || bank == 0x7F:
+		return s.snes, 0xF50000 + (addr - 0x7E0000), true
+	case (bank < 0x40 || (bank >= 0x80 && bank < 0xC0)) && lo < 0x2000:
+		return s.snes, 0xF50000 + lo, true
+	case (bank < 0x40 || (bank >= 0x80 && bank < 0xC0)) && lo >= 0x2A00 && lo < 0x3000:
+		return s.cmd, lo, true
+	default:
+		return nil, 0, false
+	}
+}
+
+// isIO determines if the SNES A-bus address maps to the PPU or DMA registers:
+func isIO(addr uint32) bool {
+	bank, lo := addr>>16, addr&0xFFFF
+	if !(bank < 0x40 || (bank >= 0x80 && bank < 0xC0)) {
+		return false
+	}
+	return (lo >= 0x2100 && lo < 0x2200) || (lo >= 0x4200 && lo < 0x4400)
+}
+
+func (s *Simulator) busRead(addr uint32) byte {
+	if isIO(addr) {
+		return s.io[addr&0xFFFF]
+	}
+	mem, offs, ok := s.bus(addr)
+	if !ok {
+		return 0
+	}
+	return mem[offs]
+}
+
+func (s *Simulator) busWrite(addr uint32, v byte) {
+	if isIO(addr) {
+		s.ioWrite(uint16(addr), v)
+		return
+	}
+	mem, offs, ok := s.bus(addr)
+	if !ok {
+		return
+	}
+	mem[offs] = v
+}
+
+// ioWrite simulates the PPU and DMA registers used to write VRAM, CGRAM and OAM:
+func (s *Simulator) ioWrite(reg uint16, v byte) {
+	s.io[reg] = v
+
+	switch reg {
+	case 0x2102, 0x2103: // OAMADDL, OAMADDH
+		s.oamAddr = (uint16(s.io[0x2102]) | uint16(s.io[0x2103]&1)<<8) << 1
+	case 0x2104: // OAMDATA
+		s.snes[oamStart+uint32(s.oamAddr)%(oamEnd-oamStart)] = v
+		s.oamAddr++
+	case 0x2116, 0x2117: // VMADDL, VMADDH
+		s.vramAddr = uint16(s.io[0x2116]) | uint16(s.io[0x2117])<<8
+	case 0x2118, 0x2119: // VMDATAL, VMDATAH
+		s.snes[vramStart+(uint32(s.vramAddr)<<1|uint32(reg&1))&0xFFFF] = v
+		// VMAIN bit 7 selects whether the address increments after the low or high byte:
+		if (s.io[0x2115]&0x80 != 0) == (reg == 0x2119) {
+			s.vramAddr++
+		}
+	case 0x2121: // CGADD
+		s.cgramAddr = uint16(v) << 1
+	case 0x2122: // CGDATA
+		s.snes[cgramStart+uint32(s.cgramAddr)&0x1FF] = v
+		s.cgramAddr++
+	case 0x420B: // MDMAEN
+		for ch := uint16(0); ch < 8; ch++ {
+			if v&(1<<ch) != 0 {
+				s.dma(ch)
+			}
+		}
+	}
+}
+
+// dma simulates a general purpose DMA transfer from the A-bus to the B-bus in transfer modes 0 and 1:
+func (s *Simulator) dma(ch uint16) {
+	regs := s.io[0x4300+ch<<4:]
+	ports := []uint16{0}
+	if regs[0]&7 == 1 {
+		ports = []uint16{0, 1}
+	}
+
+	src := uint32(regs[2]) | uint32(regs[3])<<8 | uint32(regs[4])<<16
+	size := uint32(regs[5]) | uint32(regs[6])<<8
+	if size == 0 {
+		size = 0x10000
+	}
+	for i := uint32(0); i < size; i++ {
+		s.ioWrite(0x2100|(uint16(regs[1])+ports[i%uint32(len(ports))]), s.busRead(src+i))
+	}
+}
+
+// exec interprets the subset of 65816 instructions emitted by SNI's generated NMI hook routines, starting at
+// $00:pc, until it reaches the JMP to the original NMI vector or an instruction it does not understand.
+func (s *Simulator) exec(pc uint16) {
+	var a, x, y uint16
+	m8, x8 := true, true
+	// return addresses of JSRs:
+	var stack []uint16
+
+	fetch := func() byte {
+		v := s.busRead(uint32(pc))
+		pc++
+		return v
+	}
+	fetch16 := func() uint16 {
+		lo := uint16(fetch())
+		return lo | uint16(fetch())<<8
+	}
+	imm := func(is8 bool) uint16 {
+		if is8 {
+			return uint16(fetch())
+		}
+		return fetch16()
+	}
+	store := func(addr uint32, v uint16, is8 bool) {
+		s.busWrite(addr, byte(v))
+		if !is8 {
+			s.busWrite(addr+1, byte(v>>8))
+		}
+	}
+
+	for steps := 0; steps < 0x1000; steps++ {
+		switch op := fetch(); op {
+		case 0xEA: // NOP
+		case 0xC2: // REP
+			f := fetch()
+			m8 = m8 && f&0x20 == 0
+			x8 = x8 && f&0x10 == 0
+		case 0xE2: // SEP
+			f := fetch()
+			m8 = m8 || f&0x20 != 0
+			x8 = x8 || f&0x10 != 0
+		case 0x48, 0xDA, 0x5A, 0x0B, 0x8B, 0x4B, 0x08, 0x68, 0xFA, 0x7A, 0x2B, 0xAB, 0x28:
+			// stack operations only preserve registers for the game; nothing to simulate
+		case 0x20: // JSR abs
+			target := fetch16()
+			stack = append(stack, pc)
+			pc = target
+		case 0x60: // RTS
+			if len(stack) == 0 {
+				return
+			}
+			pc = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+		case 0xA9: // LDA #
+			a = imm(m8)
+		case 0xA2: // LDX #
+			x = imm(x8)
+		case 0xA0: // LDY #
+			y = imm(x8)
+		case 0x54: // MVN dst,src
+			dst := uint32(fetch())
+			src := uint32(fetch())
+			for n := uint32(a) + 1; n > 0; n-- {
+				s.busWrite(dst<<16|uint32(y), s.busRead(src<<16|uint32(x)))
+				x++
+				y++
+			}
+			a = 0xFFFF
+		case 0xAD: // LDA abs
+			addr := uint32(fetch16())
+			a = uint16(s.busRead(addr))
+			if !m8 {
+				a |= uint16(s.busRead(addr+1)) << 8
+			}
+		case 0x8E: // STX abs
+			store(uint32(fetch16()), x, x8)
+		case 0x8D: // STA abs
+			store(uint32(fetch16()), a, m8)
+		case 0x9C: // STZ abs
+			store(uint32(fetch16()), 0, m8)
+		case 0x8F: // STA long
+			addr := uint32(fetch16())
+			addr |= uint32(fetch()) << 16
+			store(addr, a, m8)
+		default:
+			// JMP ($FFEA) or anything else ends the routine:
+			return
+		}
+	}
+}
@@ -0,0 +1,846 @@
+package fxpakpro
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+	"time"
+)
+
+func openSimulatedDevice(t *testing.T) (devices.AutoCloseableDevice, *Simulator) {
+	uri := &url.URL{Scheme: driverName, Host: "sim", Path: "/" + t.Name()}
+
+	_, d, err := devices.DeviceByUri(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = d.Close() })
+
+	return d, Simulated(uri.Path)
+}
+
+func TestSimulator_Filesystem(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	if err := d.MakeDirectory(ctx, "/roms"); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.MakeDirectory(ctx, "/roms"); err == nil {
+		t.Fatal("expected error making an existing directory")
+	}
+
+	// larger than a single 512-byte block:
+	data := bytes.Repeat([]byte("0123456789abcdef"), 100)
+	n, err := d.PutFile(ctx, "/roms/test.sfc", uint32(len(data)), bytes.NewReader(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != uint32(len(data)) {
+		t.Fatalf("PutFile() = %d, want %d", n, len(data))
+	}
+
+	// PUT does not respond after the file data so this also ensures the upload is complete:
+	entries, err := d.ReadDirectory(ctx, "/roms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name != "test.sfc" || entries[0].Type != sni.DirEntryType_File {
+		t.Fatalf("ReadDirectory() = %#v", entries)
+	}
+	if got, ok := sim.ReadFile("/roms/test.sfc"); !ok || !bytes.Equal(got, data) {
+		t.Fatal("PutFile() did not store the file contents")
+	}
+
+	b := &bytes.Buffer{}
+	size, err := d.GetFile(ctx, "/roms/test.sfc", b, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size != uint32(len(data)) || !bytes.Equal(b.Bytes(), data) {
+		t.Fatalf("GetFile() returned %d bytes, want %d", size, len(data))
+	}
+
+	if err = d.RenameFile(ctx, "/roms/test.sfc", "renamed.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sim.ReadFile("/roms/renamed.sfc"); !ok {
+		t.Fatal("RenameFile() did not rename the file")
+	}
+
+	if err = d.RemoveFile(ctx, "/roms"); err == nil {
+		t.Fatal("expected error removing a non-empty directory")
+	}
+	if err = d.RemoveFile(ctx, "/roms/renamed.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if err = d.RemoveFile(ctx, "/roms"); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err = d.ReadDirectory(ctx, "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("ReadDirectory() = %#v, want empty", entries)
+	}
+}
+
+func TestSimulator_Memory(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		address      uint32
+		addressSpace sni.AddressSpace
+		size         int
+	}{
+		{name: "SRAM", address: 0xE00010, size: 0x300},
+		{name: "WRAM", address: 0xF50010, size: 0x20},
+		{name: "VRAM", address: 0xF70011, size: 0x21},
+		{name: "CGRAM", address: 0xF90010, size: 0x20},
+		{name: "OAM", address: 0xF903F0, size: 0x30},
+		{name: "CMD", address: 0x01_002A00, size: 0x10},
+		{name: "MSU", address: 0x000100, addressSpace: sni.AddressSpace_FxPakProMSU, size: 0x10},
+		// must not be mistaken for a WRAM write:
+		{name: "MSU at WRAM offset", address: 0xF50010, addressSpace: sni.AddressSpace_FxPakProMSU, size: 0x10},
+		{name: "CONFIG", address: 0x000000, addressSpace: sni.AddressSpace_FxPakProConfig, size: 0x10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := make([]byte, tt.size)
+			for i := range data {
+				data[i] = byte(i + 1)
+			}
+			address := devices.AddressTuple{
+				Address:       tt.address,
+				AddressSpace:  tt.addressSpace,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			}
+
+			_, err := d.MultiWriteMemory(ctx, devices.MemoryWriteRequest{RequestAddress: address, Data: data})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rsp, err := d.MultiReadMemory(ctx, devices.MemoryReadRequest{RequestAddress: address, Size: tt.size})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(rsp[0].Data, data) {
+				t.Fatalf("read back %x, want %x", rsp[0].Data, data)
+			}
+			if rsp[0].DeviceAddress.AddressSpace != tt.addressSpace {
+				t.Errorf("device address space = %v, want %v", rsp[0].DeviceAddress.AddressSpace, tt.addressSpace)
+			}
+		})
+	}
+}
+
+func TestSimulator_NMIWrites(t *testing.T) {
+	d, _ := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	address := func(addr uint32) devices.AddressTuple {
+		return devices.AddressTuple{
+			Address:       addr,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		}
+	}
+
+	// WRAM, VRAM, CGRAM and OAM writes are batched into a single routine:
+	writes := []devices.MemoryWriteRequest{
+		{RequestAddress: address(0xF50100), Data: []byte{1, 2, 3}},
+		{RequestAddress: address(0xF72000), Data: []byte{4, 5, 6, 7}},
+		{RequestAddress: address(0xF90002), Data: []byte{8, 9}},
+		{RequestAddress: address(0xF90200), Data: []byte{10, 11, 12, 13}},
+		{RequestAddress: address(0xF7FFFE), Data: []byte{14, 15}},
+	}
+	if _, err := d.MultiWriteMemory(ctx, writes...); err != nil {
+		t.Fatal(err)
+	}
+
+	reads := make([]devices.MemoryReadRequest, len(writes))
+	for i, write := range writes {
+		reads[i] = devices.MemoryReadRequest{RequestAddress: write.RequestAddress, Size: len(write.Data)}
+	}
+	rsp, err := d.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, write := range writes {
+		if !bytes.Equal(rsp[i].Data, write.Data) {
+			t.Errorf("$%06x: read back %x, want %x", write.RequestAddress.Address, rsp[i].Data, write.Data)
+		}
+	}
+
+	// CGRAM and OAM are written in words:
+	_, err = d.MultiWriteMemory(ctx, devices.MemoryWriteRequest{RequestAddress: address(0xF90001), Data: []byte{1, 2}})
+	if err == nil {
+		t.Fatal("expected error writing unaligned CGRAM")
+	}
+	_, err = d.MultiWriteMemory(ctx, devices.MemoryWriteRequest{RequestAddress: address(0xF9041E), Data: []byte{1, 2, 3, 4}})
+	if err == nil {
+		t.Fatal("expected error writing past the end of OAM")
+	}
+
+	// zero-length writes must not turn into 64KiB copies that clobber their neighbours:
+	_, err = d.MultiWriteMemory(
+		ctx,
+		devices.MemoryWriteRequest{RequestAddress: address(0xF50000), Data: []byte{}},
+		devices.MemoryWriteRequest{RequestAddress: address(0xF70000), Data: []byte{}},
+		devices.MemoryWriteRequest{RequestAddress: address(0xF90000), Data: []byte{}},
+		devices.MemoryWriteRequest{RequestAddress: address(0xF90004), Data: []byte{0xAA, 0xBB}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsp, err = d.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, write := range writes {
+		if !bytes.Equal(rsp[i].Data, write.Data) {
+			t.Errorf("$%06x: read back %x after zero-length writes, want %x", write.RequestAddress.Address, rsp[i].Data, write.Data)
+		}
+	}
+	rsp, err = d.MultiReadMemory(ctx, devices.MemoryReadRequest{RequestAddress: address(0xF90004), Size: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0xAA, 0xBB}; !bytes.Equal(rsp[0].Data, want) {
+		t.Errorf("$F90004: read back %x, want %x", rsp[0].Data, want)
+	}
+}
+
+func TestSimulator_FetchFields(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	sim.WriteFile("/games/test.sfc", make([]byte, 0x8000))
+	if err := d.BootFile(ctx, "/games/test.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := d.FetchFields(
+		ctx,
+		sni.Field_DeviceName,
+		sni.Field_DeviceFeatures,
+		sni.Field_DeviceProtocolVersion,
+		sni.Field_RomFileName,
+		sni.Field_RomChips,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"FXPAK PRO STM32",
+		"FEAT_MSU1,FEAT_CMD_UNLOCK,FEAT_USB1,FEAT_DMA1",
+		"11",
+		"/games/test.sfc",
+		// a blank ROM declares no chips:
+		"",
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("FetchFields()[%d] = %q, want %q", i, values[i], want[i])
+		}
+	}
+
+	// LoROM with a DSP coprocessor:
+	rom := make([]byte, 0x8000)
+	rom[0x7FD5] = 0x20
+	rom[0x7FD6] = 0x03
+	rom[0x7FFC], rom[0x7FFD] = 0x00, 0x80
+	sim.WriteFile("/games/dsp.sfc", rom)
+	if err = d.BootFile(ctx, "/games/dsp.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err = d.FetchFields(ctx, sni.Field_RomChips)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != "DSP" {
+		t.Errorf("FetchFields(RomChips) = %q, want %q", values[0], "DSP")
+	}
+}
+
+func TestSimulator_GetEmulationState(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	if err := d.ResetToMenu(ctx); err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.State != sni.EmulationState_InMenu {
+		t.Errorf("GetEmulationState().State = %v in menu, want %v", got.State, sni.EmulationState_InMenu)
+	}
+
+	sim.WriteFile("/games/test.sfc", make([]byte, 0x8000))
+	if err = d.BootFile(ctx, "/games/test.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	got, err = d.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := devices.EmulationStatus{State: sni.EmulationState_Running, RomFileName: "/games/test.sfc"}
+	if got != want {
+		t.Errorf("GetEmulationState() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSimulator_BootRom(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	rom := make([]byte, 0x8000)
+	rom[0] = 0x5C
+	for i := 0; i < 2; i++ {
+		// booting twice must tolerate the existing directory and replace the ROM:
+		path, err := d.BootRom(ctx, devices.BootRomSource{Rom: rom, FileName: "../seed.sfc"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != "/sni/seed.sfc" {
+			t.Fatalf("BootRom() path = %q, want %q", path, "/sni/seed.sfc")
+		}
+	}
+
+	if got, ok := sim.ReadFile("/sni/seed.sfc"); !ok || !bytes.Equal(got, rom) {
+		t.Fatalf("uploaded ROM does not match")
+	}
+	got, err := d.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.RomFileName != "/sni/seed.sfc" {
+		t.Errorf("GetEmulationState().RomFileName = %q, want %q", got.RomFileName, "/sni/seed.sfc")
+	}
+
+	// files on SNI's host must not be copied to the SD card:
+	if _, err = d.BootRom(ctx, devices.BootRomSource{HostPath: "/etc/passwd.sfc"}); err == nil {
+		t.Error("expected BootRom() to refuse a hostPath")
+	}
+}
+
+func TestSimulator_ListMemoryRegions(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	sizes := func() map[string]uint32 {
+		regions, err := d.ListMemoryRegions(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sizes := make(map[string]uint32, len(regions))
+		for _, region := range regions {
+			sizes[region.Name] = region.Size
+		}
+		return sizes
+	}
+
+	// a 256KB LoROM with 8KB of SRAM:
+	rom := make([]byte, 0x8000)
+	rom[0x7FD5] = 0x20
+	rom[0x7FD7] = 0x08
+	rom[0x7FD8] = 0x03
+	rom[0x7FFC], rom[0x7FFD] = 0x00, 0x80
+	sim.WriteFile("/games/sram.sfc", rom)
+	if err := d.BootFile(ctx, "/games/sram.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := sizes()
+	want := map[string]uint32{"CARTROM": 0x40000, "SRAM": 0x2000, "MSU": 0x1000000, "CONFIG": 0x1000000}
+	for name, size := range want {
+		if got[name] != size {
+			t.Errorf("ListMemoryRegions() %s size = $%x, want $%x", name, got[name], size)
+		}
+	}
+
+	// without SRAM:
+	rom[0x7FD8] = 0x00
+	sim.WriteFile("/games/nosram.sfc", rom)
+	if err := d.BootFile(ctx, "/games/nosram.sfc"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := sizes()["SRAM"]; ok {
+		t.Error("ListMemoryRegions() lists SRAM for a ROM without SRAM")
+	}
+}
+
+func TestSimulator_StreamReadMemory(t *testing.T) {
+	for _, noStream := range []bool{false, true} {
+		name := "STREAM"
+		if noStream {
+			name = "polling"
+		}
+		t.Run(name, func(t *testing.T) {
+			d, sim := openSimulatedDevice(t)
+			sim.lock.Lock()
+			sim.NoStream = noStream
+			sim.lock.Unlock()
+
+			address := devices.AddressTuple{
+				Address:       0xF50100,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			frames := 0
+			err := d.StreamReadMemory(ctx, func(rsp []devices.MemoryReadResponse) error {
+				frames++
+				if frames == 3 {
+					// the game changes memory between frames:
+					sim.lock.Lock()
+					sim.snes[0xF50100] = 0x42
+					sim.lock.Unlock()
+				}
+				if rsp[0].Data[0] == 0x42 {
+					cancel()
+				}
+				return nil
+			}, devices.MemoryReadRequest{RequestAddress: address, Size: 0x200})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ctx.Err() != context.Canceled {
+				t.Fatal("stream did not observe the memory change")
+			}
+
+			// the device must be usable again after the stream ends:
+			if _, err = d.FetchFields(context.Background(), sni.Field_DeviceName); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestSimulator_StreamReadMemoryPaused(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	sim.lock.Lock()
+	sim.Paused = true
+	sim.lock.Unlock()
+	t.Cleanup(func() {
+		sim.lock.Lock()
+		sim.Paused = false
+		sim.lock.Unlock()
+	})
+
+	address := devices.AddressTuple{
+		Address:       0xF50180,
+		AddressSpace:  sni.AddressSpace_FxPakPro,
+		MemoryMapping: sni.MemoryMapping_LoROM,
+	}
+
+	// the console stays paused for longer than a frame may take before it resumes and changes memory:
+	go func() {
+		time.Sleep(safeTimeout + time.Millisecond*500)
+		sim.lock.Lock()
+		sim.snes[0xF50180] = 0x42
+		sim.Paused = false
+		sim.lock.Unlock()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	err := d.StreamReadMemory(ctx, func(rsp []devices.MemoryReadResponse) error {
+		if rsp[0].Data[0] == 0x42 {
+			cancel()
+		}
+		return nil
+	}, devices.MemoryReadRequest{RequestAddress: address, Size: 0x10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx.Err() != context.Canceled {
+		t.Fatal("stream did not observe the memory change after the console resumed")
+	}
+}
+
+func TestSimulator_StreamReadMemorySlowReply(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	sim.lock.Lock()
+	sim.StreamReplyDelay = safeTimeout + time.Millisecond*100
+	sim.lock.Unlock()
+	t.Cleanup(func() {
+		sim.lock.Lock()
+		sim.StreamReplyDelay = 0
+		sim.lock.Unlock()
+	})
+
+	address := devices.AddressTuple{
+		Address:       0xF50100,
+		AddressSpace:  sni.AddressSpace_FxPakPro,
+		MemoryMapping: sni.MemoryMapping_LoROM,
+	}
+	stream := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		defer cancel()
+		frames := 0
+		err := d.StreamReadMemory(ctx, func(rsp []devices.MemoryReadResponse) error {
+			if frames++; frames == 3 {
+				cancel()
+			}
+			return nil
+		}, devices.MemoryReadRequest{RequestAddress: address, Size: 0x10})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the late reply falls back to polling without giving up on STREAM for good:
+	stream()
+	uri := &url.URL{Scheme: driverName, Host: "sim", Path: "/" + t.Name()}
+	opened, ok := driver.container.GetDevice(driver.DeviceKey(uri))
+	if !ok {
+		t.Fatal("a late STREAM reply closed the device")
+	}
+	if opened.(*Device).streamUnsupported.Load() {
+		t.Fatal("a late STREAM reply disabled streaming for the device")
+	}
+
+	sim.lock.Lock()
+	sim.StreamReplyDelay = 0
+	sim.lock.Unlock()
+	stream()
+
+	if _, err := d.FetchFields(context.Background(), sni.Field_DeviceName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSimulator_StreamReadMemoryConcurrent(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	sim.lock.Lock()
+	sim.NoStream = true
+	sim.lock.Unlock()
+
+	address := devices.AddressTuple{
+		Address:       0xF50100,
+		AddressSpace:  sni.AddressSpace_FxPakPro,
+		MemoryMapping: sni.MemoryMapping_LoROM,
+	}
+
+	// concurrent streams both discover the missing STREAM support and fall back to polling:
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			frames := 0
+			errs <- d.StreamReadMemory(ctx, func(rsp []devices.MemoryReadResponse) error {
+				if frames++; frames == 3 {
+					cancel()
+				}
+				return nil
+			}, devices.MemoryReadRequest{RequestAddress: address, Size: 0x10})
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSimulator_PutFileWithOptions(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	sim.WriteFile("/roms/test.sfc", []byte("old contents"))
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 100)
+	options := devices.PutFileOptions{Verify: true, Atomic: true}
+	n, err := devices.PutFileWithOptions(ctx, d, "/roms/test.sfc", uint32(len(data)), bytes.NewReader(data), options, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != uint32(len(data)) {
+		t.Fatalf("PutFileWithOptions() = %d, want %d", n, len(data))
+	}
+	if got, ok := sim.ReadFile("/roms/test.sfc"); !ok || !bytes.Equal(got, data) {
+		t.Fatal("PutFileWithOptions() did not replace the file contents")
+	}
+	if _, ok := sim.ReadFile(devices.TempUploadName("/roms/test.sfc")); ok {
+		t.Fatal("PutFileWithOptions() left the temporary file behind")
+	}
+	if _, ok := sim.ReadFile(devices.BackupUploadName("/roms/test.sfc")); ok {
+		t.Fatal("PutFileWithOptions() left the backup behind")
+	}
+
+	if err = devices.VerifyFile(ctx, d, "/roms/test.sfc", n, make([]byte, 32)); err == nil {
+		t.Fatal("expected VerifyFile() to report a hash mismatch")
+	}
+}
+
+func TestSimulator_PutStagedUpload(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+	uri := &url.URL{Scheme: driverName, Host: "sim", Path: "/" + t.Name()}
+
+	data := bytes.Repeat([]byte("0123456789abcdef"), 100)
+
+	// the first attempt is interrupted after 1000 bytes:
+	staged, err := devices.StageUpload(uri, "/test.sfc", uint32(len(data)), 0, bytes.NewReader(data[:1000]), uint32(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staged != 1000 {
+		t.Fatalf("StageUpload() = %d, want 1000", staged)
+	}
+
+	staged, err = devices.StagedUploadSize(uri, "/test.sfc", uint32(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = devices.StageUpload(uri, "/test.sfc", uint32(len(data)), staged+1, bytes.NewReader(nil), 0); err == nil {
+		t.Fatal("expected error staging past the end of the staged data")
+	}
+
+	staged, err = devices.StageUpload(uri, "/test.sfc", uint32(len(data)), staged, bytes.NewReader(data[staged:]), uint32(len(data))-staged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staged != uint32(len(data)) {
+		t.Fatalf("StageUpload() = %d, want %d", staged, len(data))
+	}
+
+	_, err = devices.PutStagedUpload(ctx, d, uri, "/test.sfc", uint32(len(data)), devices.PutFileOptions{Verify: true}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := sim.ReadFile("/test.sfc"); !ok || !bytes.Equal(got, data) {
+		t.Fatal("PutStagedUpload() did not store the file contents")
+	}
+	if staged, _ = devices.StagedUploadSize(uri, "/test.sfc", uint32(len(data))); staged != 0 {
+		t.Fatal("PutStagedUpload() did not discard the staged upload")
+	}
+}
+
+func TestSimulator_Tree(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	files := map[string][]byte{
+		"game.sfc":          bytes.Repeat([]byte{0x55}, 0x1000),
+		"game.msu":          []byte("MSU1"),
+		"tracks/game-1.pcm": bytes.Repeat([]byte("MSU1pcm"), 300),
+		"tracks/game-2.pcm": bytes.Repeat([]byte("MSU1pcm"), 100),
+	}
+
+	archive := &bytes.Buffer{}
+	tw := tar.NewWriter(archive)
+	_ = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: "tracks/", Mode: 0755})
+	for _, name := range []string{"game.sfc", "game.msu", "tracks/game-1.pcm", "tracks/game-2.pcm"} {
+		_ = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0644, Size: int64(len(files[name]))})
+		_, _ = tw.Write(files[name])
+	}
+	_ = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: "../escape.sfc", Mode: 0644})
+	_ = tw.Close()
+
+	var entryErrors []string
+	err := devices.PutTree(ctx, d, "/msu/game", archive, func(entry devices.TreeEntry, done int, total int) {
+		if entry.Err != nil {
+			entryErrors = append(entryErrors, entry.Path)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entryErrors) != 1 {
+		t.Fatalf("PutTree() reported errors for %v, want only the escaping entry", entryErrors)
+	}
+
+	// PUT does not respond after the file data so walk the tree first to ensure the uploads are complete:
+	entries, err := devices.WalkDirectory(ctx, d, "/msu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 7 || entries[0].Path != "/msu" || entries[1].Path != "/msu/game" {
+		t.Fatalf("WalkDirectory() = %#v", entries)
+	}
+
+	for name, data := range files {
+		if got, ok := sim.ReadFile("/msu/game/" + name); !ok || !bytes.Equal(got, data) {
+			t.Fatalf("PutTree() did not store %s", name)
+		}
+	}
+
+	out := &bytes.Buffer{}
+	if err = devices.GetTree(ctx, d, "/msu/game", out, nil); err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(out)
+	got := 0
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, _ := io.ReadAll(tr)
+		if !bytes.Equal(data, files[hdr.Name]) {
+			t.Fatalf("GetTree() archived wrong contents for %s", hdr.Name)
+		}
+		got++
+	}
+	if got != len(files) {
+		t.Fatalf("GetTree() archived %d files, want %d", got, len(files))
+	}
+
+	removed := 0
+	err = devices.RemoveTree(ctx, d, "/msu", func(entry devices.TreeEntry, done int, total int) {
+		if entry.Err != nil {
+			t.Errorf("RemoveTree(): %s: %v", entry.Path, entry.Err)
+		}
+		removed = done
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 7 {
+		t.Fatalf("RemoveTree() removed %d entries, want 7", removed)
+	}
+	if entries, _ = devices.WalkDirectory(ctx, d, "/"); len(entries) != 1 {
+		t.Fatalf("RemoveTree() left %#v", entries)
+	}
+}
+
+func TestSimulator_ExecuteASM(t *testing.T) {
+	d, sim := openSimulatedDevice(t)
+	ctx := context.Background()
+
+	// copy 4 bytes of data to the result buffer:
+	code := []byte{
+		0xA9, 0x03, 0x00, // lda.w #$0003
+		0x54, 0x00, 0x00, // mvn   $00,$00
+		0x60, // rts
+	}
+	data := []byte{0xDE, 0xAD, 0xBE, 0xEF}
+
+	result, err := d.ExecuteASM(ctx, code, data, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []byte{0xDE, 0xAD, 0xBE, 0xEF, 0, 0, 0, 0}; !bytes.Equal(result, want) {
+		t.Fatalf("ExecuteASM() = %x, want %x", result, want)
+	}
+
+	if _, err = d.ExecuteASM(ctx, make([]byte, exeMaxSize+1), nil, 0); err == nil {
+		t.Fatal("expected error executing too much code")
+	}
+
+	// firmware without FEAT_CMD_UNLOCK cannot execute code:
+	sim.lock.Lock()
+	features := sim.Features
+	sim.Features &^= FeatCMD_UNLOCK
+	sim.lock.Unlock()
+	t.Cleanup(func() {
+		sim.lock.Lock()
+		sim.Features = features
+		sim.lock.Unlock()
+	})
+	if _, err = d.FetchFields(ctx, sni.Field_DeviceFeatures); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = d.ExecuteASM(ctx, code, data, 8); err == nil {
+		t.Fatal("expected error executing code without FEAT_CMD_UNLOCK")
+	}
+	if ok, _ := driver.HasCapabilities(sni.DeviceCapability_ExecuteASM); ok {
+		t.Fatal("expected ExecuteASM capability to be withdrawn without FEAT_CMD_UNLOCK")
+	}
+	if ok, _ := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); !ok {
+		t.Fatal("expected ReadMemory capability without FEAT_CMD_UNLOCK")
+	}
+}
+
+func TestSimulator_BenchmarkBaudRates(t *testing.T) {
+	config.Config.Set(baudRatesConfigKey, []interface{}{})
+	_, _ = openSimulatedDevice(t)
+	uri := &url.URL{Scheme: driverName, Host: "sim", Path: "/" + t.Name()}
+
+	type benchmark struct {
+		results []devices.BaudRateResult
+		best    int
+		err     error
+	}
+	done := make(chan benchmark)
+	go func() {
+		var b benchmark
+		b.results, b.best, b.err = driver.BenchmarkBaudRates(context.Background(), uri, []int{115200, 9600}, time.Millisecond*50)
+		done <- b
+	}()
+
+	// the device cannot be reopened while it is being benchmarked:
+	for {
+		if _, busy := driver.benchmarking.Load(driver.DeviceKey(uri)); busy {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_, busy, _ := devices.DeviceByUri(uri)
+	if _, err := busy.FetchFields(context.Background(), sni.Field_DeviceName); err == nil {
+		t.Fatal("expected error opening the device while it is benchmarked")
+	}
+
+	b := <-done
+	results, best, err := b.results, b.best, b.err
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("BenchmarkBaudRates() returned %d results, want 2", len(results))
+	}
+	for _, result := range results {
+		if result.Err != nil || result.Errors != 0 || result.Transfers == 0 {
+			t.Fatalf("BenchmarkBaudRates() result = %+v", result)
+		}
+	}
+	if best == 0 {
+		t.Fatal("BenchmarkBaudRates() found no best rate")
+	}
+	if got := rememberedBaudRate(driver.DeviceKey(uri)); got != 0 {
+		t.Fatalf("rememberedBaudRate() = %d before RememberBaudRate, want 0", got)
+	}
+	driver.RememberBaudRate(uri, best)
+	if got := rememberedBaudRate(driver.DeviceKey(uri)); got != best {
+		t.Fatalf("rememberedBaudRate() = %d, want %d", got, best)
+	}
+
+	// the device reopens after the benchmark disconnected it:
+	_, d, err := devices.DeviceByUri(uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = d.FetchFields(context.Background(), sni.Field_DeviceName); err != nil {
+		t.Fatal(err)
+	}
+}
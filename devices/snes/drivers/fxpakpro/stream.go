@@ -0,0 +1,341 @@
+package fxpakpro
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alttpo/snes/timing"
+	"runtime"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"sync"
+	"sync/atomic"
+)
+
+// deviceLock is a sync.Mutex that counts the callers waiting to acquire it so that long-running holders, e.g. memory
+// streams, can yield the device to other requests.
+type deviceLock struct {
+	sync.Mutex
+	waiting int32
+}
+
+func (l *deviceLock) Lock() {
+	atomic.AddInt32(&l.waiting, 1)
+	l.Mutex.Lock()
+	atomic.AddInt32(&l.waiting, -1)
+}
+
+func (l *deviceLock) contended() bool {
+	return atomic.LoadInt32(&l.waiting) > 0
+}
+
+var errStreamUnsupported = errors.New("fxpakpro: firmware does not support STREAM")
+
+// errStreamStalled ends a STREAM session that cannot continue without the device being broken, e.g. firmware which
+// did not reply to STREAM in time or a frame that stopped halfway. The stream falls back to polling and the next
+// stream tries STREAM again.
+var errStreamStalled = errors.New("fxpakpro: STREAM stalled")
+
+// streamQuietTimeout is how long no data must arrive to be sure an aborted STREAM session ended:
+const streamQuietTimeout = safeTimeout / 4
+
+// StreamReadMemory puts the serial link into STREAM mode for the requested reads and passes every frame of data the
+// firmware pushes to `frame`. Reads that do not fit into a single STREAM request (at most 8 chunks of 255 bytes, SNES
+// space only) or firmware without STREAM support fall back to polling with VGET once per frame, as do streams that
+// stalled.
+//
+// The stream yields the device whenever another request is waiting for it and resumes streaming afterwards.
+func (d *Device) StreamReadMemory(
+	ctx context.Context,
+	frame devices.MemoryStreamFunc,
+	reads ...devices.MemoryReadRequest,
+) (err error) {
+	var mrsp []devices.MemoryReadResponse
+	var chunks []vgetChunk
+	var ok bool
+	mrsp, chunks, ok, err = streamChunks(reads)
+	if err != nil {
+		return
+	}
+	if !ok || d.streamUnsupported.Load() {
+		return devices.PollReadMemory(ctx, d, timing.Frame, frame, reads...)
+	}
+
+	for {
+		err = d.streamSession(ctx, chunks, mrsp, frame)
+		if errors.Is(err, errStreamUnsupported) {
+			d.streamUnsupported.Store(true)
+			return devices.PollReadMemory(ctx, d, timing.Frame, frame, reads...)
+		}
+		if errors.Is(err, errStreamStalled) {
+			return devices.PollReadMemory(ctx, d, timing.Frame, frame, reads...)
+		}
+		if err != nil || ctx.Err() != nil {
+			return
+		}
+
+		// the session yielded to another request waiting on the device; let it acquire the lock before resuming:
+		runtime.Gosched()
+	}
+}
+
+// streamChunks translates the reads into the VGET-style chunk list for a STREAM request. ok is false if the reads
+// cannot be streamed with a single request.
+func streamChunks(reads []devices.MemoryReadRequest) (mrsp []devices.MemoryReadResponse, chunks []vgetChunk, ok bool, err error) {
+	mrsp = make([]devices.MemoryReadResponse, len(reads))
+	chunks = make([]vgetChunk, 0, 8)
+	for j, read := range reads {
+		if isPakOnlySpace(read.RequestAddress.AddressSpace) {
+			// only SNES space can be streamed:
+			return
+		}
+
+		mrsp[j] = devices.MemoryReadResponse{
+			RequestAddress: read.RequestAddress,
+			DeviceAddress: devices.AddressTuple{
+				Address:       0,
+				AddressSpace:  sni.AddressSpace_FxPakPro,
+				MemoryMapping: read.RequestAddress.MemoryMapping,
+			},
+			Data: make([]byte, read.Size),
+		}
+
+		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
+			read.RequestAddress,
+			sni.AddressSpace_FxPakPro,
+		)
+		if err != nil {
+			return
+		}
+
+		startAddr := mrsp[j].DeviceAddress.Address
+		if startAddr>>24 != 0 {
+			// only SNES space can be streamed:
+			return
+		}
+
+		for offs := 0; offs < read.Size; offs += 255 {
+			chunkSize := read.Size - offs
+			if chunkSize > 255 {
+				chunkSize = 255
+			}
+			if len(chunks) == 8 {
+				return
+			}
+
+			chunks = append(chunks, vgetChunk{
+				target: mrsp[j].Data[offs:],
+				size:   byte(chunkSize),
+				addr:   startAddr + uint32(offs),
+			})
+		}
+	}
+
+	ok = true
+	return
+}
+
+// streamSession holds the device lock for the duration of one STREAM session and returns when ctx is canceled, frame
+// returns an error, or another request is waiting on the device lock.
+func (d *Device) streamSession(
+	ctx context.Context,
+	chunks []vgetChunk,
+	mrsp []devices.MemoryReadResponse,
+	frame devices.MemoryStreamFunc,
+) (err error) {
+	defer d.lock.Unlock()
+	d.lock.Lock()
+
+	err = d.streamStart(ctx, chunks)
+	if errors.Is(err, errStreamStalled) {
+		if aerr := d.streamAbort(); aerr != nil {
+			err = aerr
+		}
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	defer func() {
+		var serr error
+		if errors.Is(err, errStreamStalled) {
+			serr = d.streamAbort()
+		} else {
+			serr = d.streamStop()
+		}
+		if serr != nil && (err == nil || errors.Is(err, errStreamStalled)) {
+			err = serr
+		}
+	}()
+
+	total := uint32(0)
+	for _, chunk := range chunks {
+		total += uint32(chunk.size)
+	}
+	// frames are padded to 64-byte packets:
+	expected := (total + 63) &^ 63
+	rsp := make([]byte, expected)
+
+	for ctx.Err() == nil && !d.lock.contended() {
+		frameCtx, frameCancel := context.WithTimeout(ctx, safeTimeout)
+		var n uint32
+		n, err = readExact(frameCtx, d.f, expected, rsp)
+		frameCancel()
+		if err != nil {
+			if ctx.Err() != nil {
+				// canceled in the middle of a frame; the stop command will drain the rest:
+				err = nil
+				return
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				if n == 0 {
+					// a paused console or one in the menu runs no NMI and so pushes no frames:
+					err = nil
+					continue
+				}
+				// the rest of the frame may still arrive and misalign the next ones:
+				err = errStreamStalled
+				return
+			}
+			err = d.FatalError(fmt.Errorf("stream: %w", err))
+			return
+		}
+
+		start := 0
+		for _, chunk := range chunks {
+			end := start + int(chunk.size)
+			copy(chunk.target, rsp[start:end])
+			start = end
+		}
+
+		err = frame(mrsp)
+		if err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// streamStart sends the STREAM command with the chunk list in VGET format and awaits the firmware's response packet.
+// With FlagSTREAM_BURST the firmware pushes a frame of data, padded to 64-byte packets, every SNES frame without
+// waiting for the host to request it.
+func (d *Device) streamStart(ctx context.Context, chunks []vgetChunk) (err error) {
+	sb := make([]byte, 64)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpSTREAM)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagDATA64B | FlagSTREAM_BURST)
+
+	sp := sb[32:]
+	for _, chunk := range chunks {
+		copy(sp, []byte{
+			chunk.size,
+			byte((chunk.addr >> 16) & 0xFF),
+			byte((chunk.addr >> 8) & 0xFF),
+			byte((chunk.addr >> 0) & 0xFF),
+		})
+		sp = sp[4:]
+	}
+
+	err = sendSerial(d.f, 64, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	rspCtx, rspCancel := context.WithTimeout(ctx, safeTimeout)
+	defer rspCancel()
+
+	var n uint32
+	n, err = readExact(rspCtx, d.f, 64, sb)
+	if n == 0 && errors.Is(err, context.DeadlineExceeded) {
+		// older firmware silently ignores the STREAM opcode but the reply may also just be late:
+		err = errStreamStalled
+		return
+	}
+	if err != nil {
+		err = d.FatalError(fmt.Errorf("stream: %w", err))
+		return
+	}
+	if sb[0] != 'U' || sb[1] != 'S' || sb[2] != 'B' || sb[3] != 'A' {
+		err = fmt.Errorf("stream: fxpakpro response packet does not contain USBA header")
+		err = d.FatalError(err)
+		return
+	}
+	if sb[4] != byte(OpRESPONSE) {
+		err = fmt.Errorf("stream: wrong opcode in response packet; got $%02x", sb[4])
+		err = d.FatalError(err)
+		return
+	}
+	if ec := sb[5]; ec != 0 {
+		err = errStreamUnsupported
+		return
+	}
+
+	return
+}
+
+// streamStop sends an empty STREAM command to end stream mode and discards any frame data still in flight until the
+// firmware's final response packet arrives.
+func (d *Device) streamStop() (err error) {
+	sb := make([]byte, 64)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpSTREAM)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagDATA64B)
+
+	err = sendSerial(d.f, 64, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	// give the firmware a few frames worth of time to flush:
+	ctx, cancel := context.WithTimeout(context.Background(), safeTimeout)
+	defer cancel()
+
+	for {
+		err = recvSerial(ctx, d.f, sb, 64)
+		if err != nil {
+			err = d.FatalError(fmt.Errorf("stream: could not end stream: %w", err))
+			return
+		}
+		if sb[0] == 'U' && sb[1] == 'S' && sb[2] == 'B' && sb[3] == 'A' && sb[4] == byte(OpRESPONSE) {
+			return
+		}
+	}
+}
+
+// streamAbort ends a stalled STREAM session whose replies and frames cannot be told apart anymore: it sends an empty
+// STREAM command and discards everything the firmware sends until it stays quiet. Older firmware ignores both commands
+// and sends nothing.
+func (d *Device) streamAbort() (err error) {
+	sb := make([]byte, 64)
+	sb[0], sb[1], sb[2], sb[3] = byte('U'), byte('S'), byte('B'), byte('A')
+	sb[4] = byte(OpSTREAM)
+	sb[5] = byte(SpaceSNES)
+	sb[6] = byte(FlagDATA64B)
+
+	err = sendSerial(d.f, 64, sb)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), streamQuietTimeout)
+		_, err = readExact(ctx, d.f, 64, sb)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = d.FatalError(fmt.Errorf("stream: could not abort stream: %w", err))
+			return
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package fxpakpro
+
+import (
+	"errors"
+	"go.bug.st/serial"
+	"net"
+	"os"
+	"time"
+)
+
+const tcpDialTimeout = time.Second * 2
+
+// tcpPort carries the USBA protocol over a raw TCP connection to a serial bridge (e.g. ser2net) and satisfies
+// serial.Port so the rest of the driver does not need to know the difference.
+type tcpPort struct {
+	conn        net.Conn
+	readTimeout time.Duration
+}
+
+func openTCPPort(hostport string) (f serial.Port, err error) {
+	var conn net.Conn
+	conn, err = net.DialTimeout("tcp", hostport, tcpDialTimeout)
+	if err != nil {
+		return
+	}
+
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		// latency matters more than throughput for the small USBA packets:
+		_ = tcp.SetNoDelay(true)
+	}
+
+	f = &tcpPort{conn: conn, readTimeout: serial.NoTimeout}
+	return
+}
+
+func (t *tcpPort) SetMode(mode *serial.Mode) error { return nil }
+
+func (t *tcpPort) Read(p []byte) (n int, err error) {
+	var deadline time.Time
+	if t.readTimeout >= 0 {
+		deadline = time.Now().Add(t.readTimeout)
+	}
+	err = t.conn.SetReadDeadline(deadline)
+	if err != nil {
+		return
+	}
+
+	n, err = t.conn.Read(p)
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		// behave like a serial port whose read timeout elapsed:
+		err = nil
+	}
+	return
+}
+
+func (t *tcpPort) Write(p []byte) (n int, err error) {
+	return t.conn.Write(p)
+}
+
+func (t *tcpPort) ResetInputBuffer() error { return nil }
+
+func (t *tcpPort) ResetOutputBuffer() error { return nil }
+
+func (t *tcpPort) SetDTR(dtr bool) error { return nil }
+
+func (t *tcpPort) SetRTS(rts bool) error { return nil }
+
+func (t *tcpPort) GetModemStatusBits() (*serial.ModemStatusBits, error) {
+	return &serial.ModemStatusBits{}, nil
+}
+
+func (t *tcpPort) SetReadTimeout(timeout time.Duration) error {
+	t.readTimeout = timeout
+	return nil
+}
+
+func (t *tcpPort) Close() error {
+	return t.conn.Close()
+}
+
+func (t *tcpPort) Break(time.Duration) error { return nil }
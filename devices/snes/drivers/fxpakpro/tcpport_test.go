@@ -0,0 +1,119 @@
+package fxpakpro
+
+import (
+	"context"
+	"github.com/spf13/viper"
+	"go.bug.st/serial/enumerator"
+	"io"
+	"net"
+	"net/url"
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+// serveSimulatorBridge acts like ser2net in front of a simulated cart:
+func serveSimulatorBridge(t *testing.T, sim *Simulator) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			port := sim.Open()
+			go func() {
+				_, _ = io.Copy(port, conn)
+				_ = port.Close()
+			}()
+			go func() {
+				_, _ = io.Copy(conn, port)
+				_ = conn.Close()
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func TestTCPPort(t *testing.T) {
+	sim := NewSimulator()
+	sim.WriteFile("/test.sfc", []byte{1, 2, 3})
+	hostport := serveSimulatorBridge(t, sim)
+
+	_, d, err := devices.DeviceByUri(&url.URL{Scheme: tcpScheme, Host: hostport})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+
+	if err = d.BootFile(context.Background(), "/test.sfc"); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := d.FetchFields(context.Background(), sni.Field_RomFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values[0] != "/test.sfc" {
+		t.Fatalf("FetchFields() = %q, want %q", values[0], "/test.sfc")
+	}
+}
+
+func TestDriver_DetectTCPHosts(t *testing.T) {
+	listPorts = func() ([]*enumerator.PortDetails, error) { return nil, nil }
+	t.Cleanup(func() { listPorts = enumerator.GetDetailedPortsList })
+
+	v := viper.New()
+	v.Set(tcpHostsConfigKey, []interface{}{
+		// nothing listens on port 1; configured bridges are reported without connecting to them:
+		"127.0.0.1:1",
+		map[string]interface{}{"host": "raspberrypi", "port": 2000, "name": "Living room"},
+	})
+	endpoints, err := configuredTCPHosts(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	driver.SetTCPHosts(endpoints)
+	t.Cleanup(func() { driver.SetTCPHosts(nil) })
+
+	devs, err := driver.Detect()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"tcp://127.0.0.1:1":      "127.0.0.1:1 (TCP)",
+		"tcp://raspberrypi:2000": "Living room - raspberrypi:2000 (TCP)",
+	}
+	for _, dev := range devs {
+		if dev.Uri.Host == "sim" {
+			continue
+		}
+		displayName, ok := want[dev.Uri.String()]
+		if !ok {
+			t.Fatalf("Detect() reported unexpected device %q", dev.Uri.String())
+		}
+		if dev.DisplayName != displayName {
+			t.Fatalf("DisplayName of %q = %q, want %q", dev.Uri.String(), dev.DisplayName, displayName)
+		}
+		delete(want, dev.Uri.String())
+	}
+	if len(want) > 0 {
+		t.Fatalf("Detect() did not report %v", want)
+	}
+
+	// the tcp scheme resolves to this driver:
+	drv, err := devices.DeviceDriverByUri(&url.URL{Scheme: tcpScheme, Host: "raspberrypi:2000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drv != driver {
+		t.Fatalf("DeviceDriverByUri() = %v, want the %s driver", drv, driverName)
+	}
+}
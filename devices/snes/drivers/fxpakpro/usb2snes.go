@@ -60,6 +60,28 @@ const (
 	FeatDMA1
 )
 
+var infoFlagNames = [8]string{
+	"FEAT_DSPX",
+	"FEAT_ST0010",
+	"FEAT_SRTC",
+	"FEAT_MSU1",
+	"FEAT_213F",
+	"FEAT_CMD_UNLOCK",
+	"FEAT_USB1",
+	"FEAT_DMA1",
+}
+
+// features returns the names of all set flags
+func (f info_flags) features() (names []string) {
+	names = make([]string, 0, 8)
+	for i, name := range infoFlagNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return
+}
+
 type file_type uint8
 
 const (
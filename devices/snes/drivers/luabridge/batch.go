@@ -0,0 +1,135 @@
+package luabridge
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"time"
+)
+
+// Protocol version 4 batches all ranges of a MultiReadMemory or MultiWriteMemory call into one
+// binary frame instead of sending a text command per range:
+//
+//	MultiRead|<payload length>\n<payload>
+//	MultiWrite|<payload length>\n<payload>
+//
+// The payload is a sequence of ranges, each encoded as:
+//
+//	u8     length of domain name
+//	[]byte domain name (empty for Snes9x which reads the SNES A-bus)
+//	u32be  offset
+//	u32be  size
+//	[]byte data (MultiWrite only; size bytes)
+//
+// The connector replies to MultiRead with `MultiRead|<length>\n` followed by the concatenated
+// data of all ranges in request order. MultiWrite has no reply, same as Write.
+
+// appendRange appends the encoding of a single range to a frame payload:
+func appendRange(payload []byte, domain string, offset uint32, size int) []byte {
+	payload = append(payload, byte(len(domain)))
+	payload = append(payload, domain...)
+	payload = binary.BigEndian.AppendUint32(payload, offset)
+	payload = binary.BigEndian.AppendUint32(payload, uint32(size))
+	return payload
+}
+
+// frame prefixes a payload with its command header line:
+func frame(command string, payload []byte) []byte {
+	header := fmt.Sprintf("%s|%d\n", command, len(payload))
+	return append([]byte(header), payload...)
+}
+
+func (d *Device) batchReadMemory(reads []devices.MemoryReadRequest, deadline time.Time) (rsp []devices.MemoryReadResponse, err error) {
+	rsp = make([]devices.MemoryReadResponse, len(reads))
+	if len(reads) == 0 {
+		return
+	}
+
+	total := 0
+	payload := make([]byte, 0, 16*len(reads))
+	for j, read := range reads {
+		var domain mapping.MemoryType
+		var offset uint32
+		var deviceAddress devices.AddressTuple
+		domain, offset, deviceAddress, err = d.target(read.RequestAddress)
+		if err != nil {
+			return
+		}
+
+		payload = appendRange(payload, string(domain), offset, read.Size)
+		total += read.Size
+
+		rsp[j] = devices.MemoryReadResponse{
+			RequestAddress: read.RequestAddress,
+			DeviceAddress:  deviceAddress,
+		}
+	}
+
+	if config.VerboseLogging {
+		d.log("> MultiRead|%d (%d ranges)\n", len(payload), len(reads))
+	}
+
+	var data []byte
+	data, err = d.WriteThenReadFrame(frame("MultiRead", payload), "MultiRead", deadline)
+	if err != nil {
+		return
+	}
+
+	if config.LogResponses {
+		d.log("< MultiRead|%d\n", len(data))
+	}
+
+	if actual, expected := len(data), total; actual != expected {
+		err = fmt.Errorf("response did not provide enough data to meet request size; actual $%x, expected $%x", actual, expected)
+		err = d.FatalError(err)
+		return
+	}
+
+	for j, read := range reads {
+		rsp[j].Data = data[:read.Size:read.Size]
+		data = data[read.Size:]
+	}
+
+	return
+}
+
+func (d *Device) batchWriteMemory(writes []devices.MemoryWriteRequest, deadline time.Time) (rsp []devices.MemoryWriteResponse, err error) {
+	rsp = make([]devices.MemoryWriteResponse, len(writes))
+	if len(writes) == 0 {
+		return
+	}
+
+	size := 0
+	for _, write := range writes {
+		size += 16 + len(write.Data)
+	}
+
+	payload := make([]byte, 0, size)
+	for j, write := range writes {
+		var domain mapping.MemoryType
+		var offset uint32
+		var deviceAddress devices.AddressTuple
+		domain, offset, deviceAddress, err = d.target(write.RequestAddress)
+		if err != nil {
+			return
+		}
+
+		payload = appendRange(payload, string(domain), offset, len(write.Data))
+		payload = append(payload, write.Data...)
+
+		rsp[j] = devices.MemoryWriteResponse{
+			RequestAddress: write.RequestAddress,
+			DeviceAddress:  deviceAddress,
+			Size:           len(write.Data),
+		}
+	}
+
+	if config.VerboseLogging {
+		d.log("> MultiWrite|%d (%d ranges)\n", len(payload), len(writes))
+	}
+
+	_, err = d.WriteDeadline(frame("MultiWrite", payload), deadline)
+	return
+}
@@ -2,6 +2,16 @@ package luabridge
 
 import (
 	"context"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
 	"time"
 )
 
@@ -43,3 +53,226 @@ func (d *Device) PauseToggle(ctx context.Context) (err error) {
 	_, err = d.WriteDeadline([]byte("PauseToggle\n"), deadline)
 	return
 }
+
+// SaveState saves to a numbered slot or, on BizHawk, to a state file. The connector does not reply to either.
+func (d *Device) SaveState(ctx context.Context, target devices.SaveStateTarget) (state []byte, err error) {
+	var cmd string
+	cmd, err = d.stateCommand("SaveState", target)
+	if err != nil {
+		return
+	}
+
+	err = d.writeCommand(ctx, cmd)
+	return
+}
+
+// LoadState loads from a numbered slot or, on BizHawk, from a state file.
+func (d *Device) LoadState(ctx context.Context, target devices.SaveStateTarget) (err error) {
+	var cmd string
+	cmd, err = d.stateCommand("LoadState", target)
+	if err != nil {
+		return
+	}
+
+	err = d.writeCommand(ctx, cmd)
+	return
+}
+
+func (d *Device) stateCommand(name string, target devices.SaveStateTarget) (cmd string, err error) {
+	if target.Transfer {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: transferring states is not supported"))
+		return
+	}
+	if target.Path == "" {
+		cmd = fmt.Sprintf("%sSlot|%d\n", name, target.Slot)
+		return
+	}
+
+	if !d.isBizHawk {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: only BizHawk supports state files"))
+		return
+	}
+	if strings.ContainsAny(target.Path, "|\n") {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("luabridge: state path may not contain '|' or newlines"))
+		return
+	}
+	cmd = fmt.Sprintf("%s|%s\n", name, target.Path)
+	return
+}
+
+func (d *Device) writeCommand(ctx context.Context, cmd string) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	if config.VerboseLogging {
+		d.log("> %s", cmd)
+	}
+	_, err = d.WriteDeadline([]byte(cmd), deadline)
+	return
+}
+
+// BootRom asks BizHawk to open the ROM from a path on this host which requires the connector to run on this host. The
+// connector does not reply.
+func (d *Device) BootRom(ctx context.Context, source devices.BootRomSource) (path string, err error) {
+	if !d.isBizHawk {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: only BizHawk can boot ROMs"))
+		return
+	}
+	if !d.commands["BootRom"] {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: connector is too old to boot ROMs"))
+		return
+	}
+	if addr, ok := d.c.RemoteAddr().(*net.TCPAddr); !ok || !addr.IP.IsLoopback() {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("luabridge: booting ROMs requires the connector to run on this host"))
+		return
+	}
+
+	path, err = source.LocalPath()
+	if err != nil {
+		return
+	}
+	if strings.ContainsAny(path, "|\n") {
+		err = devices.WithCode(codes.InvalidArgument, fmt.Errorf("luabridge: ROM path may not contain '|' or newlines"))
+		return
+	}
+
+	err = d.writeCommand(ctx, fmt.Sprintf("BootRom|%s\n", path))
+	return
+}
+
+// Screenshot asks BizHawk to save a PNG screenshot to a temporary file and transfers it if the connector runs on this
+// host; otherwise only the path on the connector's host is returned.
+func (d *Device) Screenshot(ctx context.Context) (image []byte, mimeType string, path string, err error) {
+	if !d.isBizHawk {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: only BizHawk supports screenshots"))
+		return
+	}
+	if !d.commands["Screenshot"] {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: connector is too old to take screenshots"))
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	local := false
+	if addr, ok := d.c.RemoteAddr().(*net.TCPAddr); ok && addr.IP.IsLoopback() {
+		local = true
+	}
+
+	path = fmt.Sprintf("sni-screenshot-%d.png", time.Now().UnixNano())
+	if local {
+		path = filepath.Join(os.TempDir(), path)
+	}
+	if strings.ContainsAny(path, "|\n") {
+		err = devices.WithCode(codes.FailedPrecondition, fmt.Errorf("luabridge: temporary path may not contain '|'"))
+		return
+	}
+
+	cmd := fmt.Sprintf("Screenshot|%s\n", path)
+	if config.VerboseLogging {
+		d.log("> %s", cmd)
+	}
+
+	// the connector replies with the path once the screenshot is saved:
+	var rsp []byte
+	rsp, err = d.WriteThenReadUntilNewline([]byte(cmd), deadline)
+	if err != nil {
+		return
+	}
+	if config.LogResponses {
+		d.log("< %s", rsp)
+	}
+	if got := strings.TrimRight(string(rsp), "\r\n"); got != "Screenshot|"+path {
+		err = d.FatalError(fmt.Errorf("luabridge: expected Screenshot response but got '%s'", got))
+		return
+	}
+
+	if !local {
+		return
+	}
+
+	image, err = os.ReadFile(path)
+	_ = os.Remove(path)
+	if err != nil {
+		return
+	}
+	mimeType, path = "image/png", ""
+	return
+}
+
+// FrameAdvance unpauses BizHawk for the given number of frames and pauses it again.
+func (d *Device) FrameAdvance(ctx context.Context, frames int) (err error) {
+	if !d.isBizHawk {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: only BizHawk supports frame advance"))
+		return
+	}
+
+	err = d.writeCommand(ctx, fmt.Sprintf("FrameAdvance|%d\n", frames))
+	return
+}
+
+// SetEmulationSpeed sets BizHawk's speed percentage; Snes9x only supports turbo for any speed above normal.
+func (d *Device) SetEmulationSpeed(ctx context.Context, speed float64) (err error) {
+	if !d.isBizHawk && speed < 1 {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("luabridge: Snes9x does not support slow-motion"))
+		return
+	}
+
+	err = d.writeCommand(ctx, fmt.Sprintf("SetSpeed|%d\n", int(math.Round(speed*100))))
+	return
+}
+
+// GetEmulationState asks the connector whether emulation is paused and which ROM is loaded. Connectors that do not
+// advertise the EmulationState command report an unknown state.
+func (d *Device) GetEmulationState(ctx context.Context) (status devices.EmulationStatus, err error) {
+	if !d.commands["EmulationState"] {
+		status.State = sni.EmulationState_StateUnknown
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	cmd := "EmulationState\n"
+	if config.VerboseLogging {
+		d.log("> %s", cmd)
+	}
+
+	// EmulationState|paused|Legend of Zelda, The - A Link to the Past (USA)
+	var rsp []byte
+	rsp, err = d.WriteThenReadUntilNewline([]byte(cmd), deadline)
+	if err != nil {
+		return
+	}
+	if config.LogResponses {
+		d.log("< %s", rsp)
+	}
+
+	rspn := strings.SplitN(strings.TrimRight(string(rsp), "\r\n"), "|", 3)
+	if rspn[0] != "EmulationState" || len(rspn) < 2 {
+		err = d.FatalError(fmt.Errorf("luabridge: expected EmulationState response but got '%s'", rsp))
+		return
+	}
+
+	switch rspn[1] {
+	case "running":
+		status.State = sni.EmulationState_Running
+	case "paused":
+		status.State = sni.EmulationState_Paused
+	case "nocontent":
+		status.State = sni.EmulationState_NoContent
+	default:
+		status.State = sni.EmulationState_StateUnknown
+	}
+	if len(rspn) >= 3 {
+		status.RomFileName = rspn[2]
+	}
+	return
+}
@@ -4,11 +4,14 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"net"
+	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
 	"sni/util"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -28,9 +31,13 @@ type Device struct {
 
 	clientName string
 	version    string
-	host       string
-	isBizHawk  bool
-	logPrefix  string
+	// protocol version of the SNI Connector script; 0 for other clients:
+	protocol  int
+	host      string
+	isBizHawk bool
+	// optional commands the connector advertises in its Version response that it replies to:
+	commands  map[string]bool
+	logPrefix string
 }
 
 func (d *Device) FatalError(cause error) devices.DeviceError {
@@ -130,8 +137,14 @@ func (d *Device) CheckVersion() (err error) {
 	// Version|SNI Connector|2|Bizhawk-bsnes
 	// Version|SNI Connector|2|Bizhawk-snes9x
 	// Version|SNI Connector|2|Snes9x
+	// Version|SNI Connector|3|Bizhawk|EmulationState,Screenshot
+	// Version|SNI Connector|4|Bizhawk|EmulationState,GameInfo,Screenshot,BootRom,MemoryDomains
 	d.clientName = rspn[1]
 	d.version = rspn[2]
+	d.protocol = 0
+	if d.clientName == "SNI Connector" {
+		d.protocol, _ = strconv.Atoi(d.version)
+	}
 	if len(rspn) >= 4 {
 		d.host = strings.ToLower(rspn[3])
 		d.isBizHawk = strings.HasPrefix(d.host, "bizhawk")
@@ -139,10 +152,34 @@ func (d *Device) CheckVersion() (err error) {
 		d.host = ""
 		d.isBizHawk = false
 	}
+	commands := make(map[string]bool)
+	if len(rspn) >= 5 {
+		for _, command := range strings.Split(rspn[4], ",") {
+			commands[command] = true
+		}
+	}
+	d.commands = commands
 	return
 }
 
 func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values []string, err error) {
+	wantGameInfo := false
+	for _, field := range fields {
+		switch field {
+		case sni.Field_DeviceStatus, sni.Field_CoreName, sni.Field_RomFileName, sni.Field_RomHashType, sni.Field_RomHashValue:
+			wantGameInfo = true
+			break
+		}
+	}
+
+	var info gameInfo
+	if wantGameInfo {
+		info, err = d.gameInfo(ctx)
+		if err != nil {
+			return
+		}
+	}
+
 	for _, field := range fields {
 		switch field {
 		case sni.Field_DeviceName:
@@ -151,6 +188,21 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 		case sni.Field_DeviceVersion:
 			values = append(values, d.version)
 			break
+		case sni.Field_DeviceStatus:
+			values = append(values, info.status)
+			break
+		case sni.Field_CoreName:
+			values = append(values, info.coreName)
+			break
+		case sni.Field_RomFileName:
+			values = append(values, info.romFileName)
+			break
+		case sni.Field_RomHashType:
+			values = append(values, info.romHashType)
+			break
+		case sni.Field_RomHashValue:
+			values = append(values, info.romHashValue)
+			break
 		default:
 			// unknown value; append empty string to maintain index association:
 			values = append(values, "")
@@ -161,6 +213,104 @@ func (d *Device) FetchFields(ctx context.Context, fields ...sni.Field) (values [
 	return
 }
 
+type gameInfo struct {
+	status       string
+	coreName     string
+	romFileName  string
+	romHashType  string
+	romHashValue string
+}
+
+// gameInfo asks connectors that advertise the GameInfo command for the emulation state, core and ROM. Older
+// connectors only report the emulation state and ROM name through the EmulationState command, if at all.
+func (d *Device) gameInfo(ctx context.Context) (info gameInfo, err error) {
+	if !d.commands["GameInfo"] {
+		if !d.commands["EmulationState"] {
+			return
+		}
+
+		var status devices.EmulationStatus
+		status, err = d.GetEmulationState(ctx)
+		if err != nil {
+			return
+		}
+		// same words as the connector reports:
+		switch status.State {
+		case sni.EmulationState_Running:
+			info.status = "running"
+		case sni.EmulationState_Paused:
+			info.status = "paused"
+		case sni.EmulationState_NoContent:
+			info.status = "nocontent"
+		default:
+			info.status = "unknown"
+		}
+		info.romFileName = status.RomFileName
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	cmd := "GameInfo\n"
+	if config.VerboseLogging {
+		d.log("> %s", cmd)
+	}
+
+	// GameInfo|SNES|SHA1:0123456789ABCDEF0123456789ABCDEF01234567|paused|Legend of Zelda, The - A Link to the Past (USA)
+	var rsp []byte
+	rsp, err = d.WriteThenReadUntilNewline([]byte(cmd), deadline)
+	if err != nil {
+		return
+	}
+	if config.LogResponses {
+		d.log("< %s", rsp)
+	}
+
+	// the ROM name comes last since it may contain '|':
+	rspn := strings.SplitN(strings.TrimRight(string(rsp), "\r\n"), "|", 5)
+	if rspn[0] != "GameInfo" || len(rspn) < 4 {
+		err = d.FatalError(fmt.Errorf("luabridge: expected GameInfo response but got '%s'", rsp))
+		return
+	}
+
+	info.coreName = rspn[1]
+	info.romHashType, info.romHashValue = parseRomHash(rspn[2])
+	info.status = rspn[3]
+	if len(rspn) >= 5 {
+		info.romFileName = rspn[4]
+	}
+	return
+}
+
+// parseRomHash splits BizHawk's ROM hash into its type and value. Newer BizHawk versions prefix the hash with its
+// algorithm, e.g. "SHA1:0123...", while older versions report bare hex digits whose algorithm follows from their length.
+func parseRomHash(hash string) (hashType string, hashValue string) {
+	if hash == "" {
+		return
+	}
+
+	if prefix, value, found := strings.Cut(hash, ":"); found {
+		return strings.ToLower(prefix), strings.ToLower(value)
+	}
+
+	switch len(hash) {
+	case 8:
+		hashType = "crc32"
+	case 32:
+		hashType = "md5"
+	case 40:
+		hashType = "sha1"
+	default:
+		// unknown algorithm:
+		return
+	}
+	hashValue = strings.ToLower(hash)
+	return
+}
+
 func (d *Device) WriteDeadline(write []byte, deadline time.Time) (n int, err error) {
 	defer d.lock.Unlock()
 	d.lock.Lock()
@@ -211,6 +361,59 @@ func (d *Device) WriteThenReadUntilNewline(write []byte, deadline time.Time) (li
 	return
 }
 
+// WriteThenReadFrame writes a command and reads back its binary reply frame: a `command|length`
+// header line followed by exactly length bytes of payload.
+func (d *Device) WriteThenReadFrame(write []byte, command string, deadline time.Time) (payload []byte, err error) {
+	defer d.lock.Unlock()
+	d.lock.Lock()
+
+	err = d.c.SetWriteDeadline(deadline)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	_, err = d.c.Write(write)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	err = d.c.SetReadDeadline(deadline)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	var header []byte
+	header, err = d.lineReader.ReadBytes('\n')
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	parts := strings.Split(strings.TrimRight(string(header), "\r\n "), "|")
+	if len(parts) != 2 || parts[0] != command {
+		err = d.FatalError(fmt.Errorf("expected %s frame header but got %q", command, header))
+		return
+	}
+	var length int
+	length, err = strconv.Atoi(parts[1])
+	if err != nil || length < 0 {
+		err = d.FatalError(fmt.Errorf("invalid %s frame length %q", command, parts[1]))
+		return
+	}
+
+	payload = make([]byte, length)
+	_, err = io.ReadFull(d.lineReader, payload)
+	if err != nil {
+		err = d.FatalError(err)
+		return
+	}
+
+	return
+}
+
 func (d *Device) Close() (err error) {
 	if d.isClosed {
 		return nil
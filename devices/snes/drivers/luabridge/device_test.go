@@ -0,0 +1,129 @@
+package luabridge
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestDevice_FetchFields(t *testing.T) {
+	driver = &Driver{devicesMap: make(map[string]*Device)}
+
+	tests := []struct {
+		name    string
+		version string
+		reply   string
+		want    []string
+	}{
+		{
+			name:    "GameInfo",
+			version: "Version|SNI Connector|4|Bizhawk|EmulationState,GameInfo\n",
+			reply:   "GameInfo|SNES|SHA1:0123456789ABCDEF0123456789ABCDEF01234567|paused|Super Game | Deluxe (USA)\n",
+			want:    []string{"paused", "SNES", "Super Game | Deluxe (USA)", "sha1", "0123456789abcdef0123456789abcdef01234567"},
+		},
+		{
+			name:    "EmulationState only",
+			version: "Version|SNI Connector|3|Bizhawk|EmulationState\n",
+			reply:   "EmulationState|running|Super Game (USA)\n",
+			want:    []string{"running", "", "Super Game (USA)", "", ""},
+		},
+		{
+			name:    "BizHawk movie",
+			version: "Version|SNI Connector|4|Bizhawk|EmulationState,GameInfo\n",
+			reply:   "GameInfo|BSNESv115+|SHA1:0123456789ABCDEF0123456789ABCDEF01234567|running|Super Game (USA)\n",
+			want:    []string{"running", "BSNESv115+", "Super Game (USA)", "sha1", "0123456789abcdef0123456789abcdef01234567"},
+		},
+		{
+			name:    "Snes9x",
+			version: "Version|SNI Connector|4|Snes9x|EmulationState,GameInfo\n",
+			reply:   "GameInfo|Snes9x||running|\n",
+			want:    []string{"running", "Snes9x", "", "", ""},
+		},
+		{
+			name:    "no commands",
+			version: "Version|SNI Connector|2|Snes9x\n",
+			want:    []string{"", "", "", "", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+
+			go func() {
+				conn, err := net.Dial("tcp", l.Addr().String())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer conn.Close()
+
+				r := bufio.NewReader(conn)
+				for {
+					line, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if line == "Version\n" {
+						_, _ = fmt.Fprint(conn, tt.version)
+					} else {
+						_, _ = fmt.Fprint(conn, tt.reply)
+					}
+				}
+			}()
+
+			conn, err := l.AcceptTCP()
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := NewDevice(conn, conn.RemoteAddr().String())
+			defer d.Close()
+			if err = d.CheckVersion(); err != nil {
+				t.Fatal(err)
+			}
+
+			values, err := d.FetchFields(
+				context.Background(),
+				sni.Field_DeviceStatus,
+				sni.Field_CoreName,
+				sni.Field_RomFileName,
+				sni.Field_RomHashType,
+				sni.Field_RomHashValue,
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("FetchFields() = %q, want %q", values, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseRomHash(t *testing.T) {
+	tests := []struct {
+		hash          string
+		wantHashType  string
+		wantHashValue string
+	}{
+		{"", "", ""},
+		{"SHA1:ABCDEF0123456789ABCDEF0123456789ABCDEF01", "sha1", "abcdef0123456789abcdef0123456789abcdef01"},
+		{"ABCDEF0123456789ABCDEF0123456789ABCDEF01", "sha1", "abcdef0123456789abcdef0123456789abcdef01"},
+		{"0123456789ABCDEF0123456789ABCDEF", "md5", "0123456789abcdef0123456789abcdef"},
+		{"DEADBEEF", "crc32", "deadbeef"},
+		{"N/A", "", ""},
+	}
+	for _, tt := range tests {
+		gotHashType, gotHashValue := parseRomHash(tt.hash)
+		if gotHashType != tt.wantHashType || gotHashValue != tt.wantHashValue {
+			t.Errorf("parseRomHash(%q) = (%q, %q), want (%q, %q)", tt.hash, gotHashType, gotHashValue, tt.wantHashType, tt.wantHashValue)
+		}
+	}
+}
@@ -54,6 +54,13 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_PauseUnpauseEmulation,
 	sni.DeviceCapability_PauseToggleEmulation,
 	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_Screenshot,
+	sni.DeviceCapability_FrameAdvance,
+	sni.DeviceCapability_SetEmulationSpeed,
+	sni.DeviceCapability_GetEmulationState,
+	sni.DeviceCapability_BootRom,
+	sni.DeviceCapability_ListMemoryRegions,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
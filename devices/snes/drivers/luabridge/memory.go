@@ -9,6 +9,7 @@ import (
 	"sni/devices/snes/mapping"
 	"sni/protos/sni"
 	"strconv"
+	"strings"
 	"time"
 )
 import jsoniter "github.com/json-iterator/go"
@@ -71,28 +72,26 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 		deadline = time.Now().Add(readWriteTimeout)
 	}
 
+	if d.protocol >= 4 {
+		rsp, err = d.batchReadMemory(reads, deadline)
+		return
+	}
+
 	rsp = make([]devices.MemoryReadResponse, len(reads))
 	for j, read := range reads {
-		var addr uint32
-		var addressSpace sni.AddressSpace
+		var domain mapping.MemoryType
+		var offset uint32
+		var deviceAddress devices.AddressTuple
+		domain, offset, deviceAddress, err = d.target(read.RequestAddress)
+		if err != nil {
+			return
+		}
 
 		sb := bytes.NewBuffer(make([]byte, 0, 64))
 		if d.isBizHawk {
-			var domain mapping.MemoryType
-			var offset uint32
-			addressSpace = sni.AddressSpace_FxPakPro
-			domain, addr, offset = mapping.MemoryTypeFor(read.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
 			_, _ = fmt.Fprintf(sb, "Read|%d|%d|%s\n", offset, read.Size, domain)
 		} else {
-			addressSpace = sni.AddressSpace_SnesABus
-			addr, err = mapping.TranslateAddress(read.RequestAddress, addressSpace)
-			if err != nil {
-				return
-			}
-			_, _ = fmt.Fprintf(sb, "Read|%d|%d\n", addr, read.Size)
+			_, _ = fmt.Fprintf(sb, "Read|%d|%d\n", offset, read.Size)
 		}
 
 		if config.VerboseLogging {
@@ -110,15 +109,9 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 		}
 
 		var data []byte
-		if d.clientName == "SNI Connector" {
-			version, _ := strconv.Atoi(d.version)
-			if version >= 3 {
-				// parse response as hex bytes:
-				data, err = d.parseHexResponse(rspstr)
-			} else {
-				// parse response as json:
-				data, err = d.parseJsonResponse(rspstr)
-			}
+		if d.protocol >= 3 {
+			// parse response as hex bytes:
+			data, err = d.parseHexResponse(rspstr)
 		} else {
 			// parse response as json:
 			data, err = d.parseJsonResponse(rspstr)
@@ -136,18 +129,43 @@ func (d *Device) MultiReadMemory(ctx context.Context, reads ...devices.MemoryRea
 
 		rsp[j] = devices.MemoryReadResponse{
 			RequestAddress: read.RequestAddress,
-			DeviceAddress: devices.AddressTuple{
-				Address:       addr,
-				AddressSpace:  addressSpace,
-				MemoryMapping: read.RequestAddress.MemoryMapping,
-			},
-			Data: data,
+			DeviceAddress:  deviceAddress,
+			Data:           data,
 		}
 	}
 
 	return
 }
 
+// target translates a request address into the memory domain and offset the connector reads and
+// writes; Snes9x has no domains and takes SNES A-bus addresses as offsets.
+func (d *Device) target(address devices.AddressTuple) (domain mapping.MemoryType, offset uint32, deviceAddress devices.AddressTuple, err error) {
+	if d.isBizHawk {
+		var addr uint32
+		domain, addr, offset = mapping.MemoryTypeFor(address)
+		if domain == "SRAM" {
+			domain = "CARTRAM"
+		}
+		deviceAddress = devices.AddressTuple{
+			Address:       addr,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: address.MemoryMapping,
+		}
+		return
+	}
+
+	offset, err = mapping.TranslateAddress(address, sni.AddressSpace_SnesABus)
+	if err != nil {
+		return
+	}
+	deviceAddress = devices.AddressTuple{
+		Address:       offset,
+		AddressSpace:  sni.AddressSpace_SnesABus,
+		MemoryMapping: address.MemoryMapping,
+	}
+	return
+}
+
 func (d *Device) parseHexResponse(hexstr []byte) (data []byte, err error) {
 	err = nil
 	tnl := bytes.LastIndexByte(hexstr, '\n')
@@ -222,29 +240,27 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 		deadline = time.Now().Add(readWriteTimeout)
 	}
 
+	if d.protocol >= 4 {
+		rsp, err = d.batchWriteMemory(writes, deadline)
+		return
+	}
+
 	rsp = make([]devices.MemoryWriteResponse, len(writes))
 	for j, write := range writes {
-		var addr uint32
-		var addressSpace sni.AddressSpace
+		var domain mapping.MemoryType
+		var offset uint32
+		var deviceAddress devices.AddressTuple
+		domain, offset, deviceAddress, err = d.target(write.RequestAddress)
+		if err != nil {
+			return
+		}
 
 		// preallocate enough space to write the whole command:
 		sb := bytes.NewBuffer(make([]byte, 0, 24+4*len(write.Data)))
 		if d.isBizHawk {
-			var domain mapping.MemoryType
-			var offset uint32
-			addressSpace = sni.AddressSpace_FxPakPro
-			domain, addr, offset = mapping.MemoryTypeFor(write.RequestAddress)
-			if domain == "SRAM" {
-				domain = "CARTRAM"
-			}
 			_, _ = fmt.Fprintf(sb, "Write|%d|%s", offset, domain)
 		} else {
-			addressSpace = sni.AddressSpace_SnesABus
-			addr, err = mapping.TranslateAddress(write.RequestAddress, addressSpace)
-			if err != nil {
-				return
-			}
-			_, _ = fmt.Fprintf(sb, "Write|%d", addr)
+			_, _ = fmt.Fprintf(sb, "Write|%d", offset)
 		}
 		for _, b := range write.Data {
 			_, _ = fmt.Fprintf(sb, "|%d", b)
@@ -265,14 +281,73 @@ func (d *Device) MultiWriteMemory(ctx context.Context, writes ...devices.MemoryW
 
 		rsp[j] = devices.MemoryWriteResponse{
 			RequestAddress: write.RequestAddress,
-			DeviceAddress: devices.AddressTuple{
-				Address:       addr,
-				AddressSpace:  addressSpace,
-				MemoryMapping: write.RequestAddress.MemoryMapping,
-			},
-			Size: len(write.Data),
+			DeviceAddress:  deviceAddress,
+			Size:           len(write.Data),
+		}
+	}
+
+	return
+}
+
+// ListMemoryRegions asks BizHawk connectors that advertise the MemoryDomains command for the sizes of the cart's memory
+// domains; other connectors report the largest regions each memory type can have.
+func (d *Device) ListMemoryRegions(ctx context.Context) (regions []devices.MemoryRegion, err error) {
+	if !d.isBizHawk || !d.commands["MemoryDomains"] {
+		for _, memoryType := range []mapping.MemoryType{mapping.MemoryTypeWRAM, mapping.MemoryTypeSRAM, mapping.MemoryTypeROM} {
+			// Snes9x writes to the SNES A-bus which cannot change ROM:
+			writable := d.isBizHawk || memoryType != mapping.MemoryTypeROM
+			region, _ := mapping.MemoryRegionFor(memoryType, 0, true, writable)
+			regions = append(regions, region)
 		}
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(readWriteTimeout)
+	}
+
+	cmd := "MemoryDomains\n"
+	if config.VerboseLogging {
+		d.log("> %s", cmd)
+	}
+
+	// MemoryDomains|WRAM:131072|CARTROM:1048576|CARTRAM:8192|VRAM:65536|...
+	var rsp []byte
+	rsp, err = d.WriteThenReadUntilNewline([]byte(cmd), deadline)
+	if err != nil {
+		return
+	}
+	if config.LogResponses {
+		d.log("< %s", rsp)
 	}
 
+	rspn := strings.Split(strings.TrimRight(string(rsp), "\r\n"), "|")
+	if rspn[0] != "MemoryDomains" {
+		err = d.FatalError(fmt.Errorf("luabridge: expected MemoryDomains response but got '%s'", rsp))
+		return
+	}
+
+	// only the domains that reads and writes are translated to can be reached:
+	for _, domain := range rspn[1:] {
+		name, sizeStr, found := strings.Cut(domain, ":")
+		if !found {
+			continue
+		}
+		size, perr := strconv.ParseUint(sizeStr, 10, 32)
+		if perr != nil || size == 0 {
+			continue
+		}
+
+		memoryType := mapping.MemoryType(name)
+		if name == "CARTRAM" {
+			memoryType = mapping.MemoryTypeSRAM
+		}
+		region, ok := mapping.MemoryRegionFor(memoryType, uint32(size), true, true)
+		if !ok {
+			continue
+		}
+		regions = append(regions, region)
+	}
 	return
 }
@@ -0,0 +1,229 @@
+package luabridge
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"sni/devices"
+	"sni/protos/sni"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeConnector emulates the Snes9x flavor of Connector.lua over SNES A-bus memory:
+func fakeConnector(t *testing.T, conn net.Conn, version string, commands *[]string) {
+	memory := make(map[uint32]byte)
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		parts := strings.Split(strings.TrimRight(line, "\n"), "|")
+		*commands = append(*commands, parts[0])
+
+		switch parts[0] {
+		case "Version":
+			_, _ = fmt.Fprintf(conn, "Version|SNI Connector|%s|Snes9x|EmulationState\n", version)
+		case "Read":
+			addr, _ := strconv.Atoi(parts[1])
+			size, _ := strconv.Atoi(parts[2])
+			sb := strings.Builder{}
+			for i := 0; i < size; i++ {
+				_, _ = fmt.Fprintf(&sb, "%02x", memory[uint32(addr+i)])
+			}
+			_, _ = fmt.Fprintf(conn, "%s\n", sb.String())
+		case "Write":
+			addr, _ := strconv.Atoi(parts[1])
+			for i, v := range parts[2:] {
+				b, _ := strconv.Atoi(v)
+				memory[uint32(addr+i)] = byte(b)
+			}
+		case "MultiRead", "MultiWrite":
+			length, _ := strconv.Atoi(parts[1])
+			payload := make([]byte, length)
+			if _, err = io.ReadFull(r, payload); err != nil {
+				t.Error(err)
+				return
+			}
+			data := make([]byte, 0, length)
+			for len(payload) > 0 {
+				n := int(payload[0])
+				if domain := string(payload[1 : 1+n]); domain != "" {
+					t.Errorf("%s domain = %q, want none for Snes9x", parts[0], domain)
+				}
+				payload = payload[1+n:]
+				offset := binary.BigEndian.Uint32(payload[0:4])
+				size := binary.BigEndian.Uint32(payload[4:8])
+				payload = payload[8:]
+				for i := uint32(0); i < size; i++ {
+					if parts[0] == "MultiRead" {
+						data = append(data, memory[offset+i])
+					} else {
+						memory[offset+i] = payload[i]
+					}
+				}
+				if parts[0] == "MultiWrite" {
+					payload = payload[size:]
+				}
+			}
+			if parts[0] == "MultiRead" {
+				_, _ = fmt.Fprintf(conn, "MultiRead|%d\n%s", len(data), data)
+			}
+		}
+	}
+}
+
+func TestDevice_MultiReadWriteMemory(t *testing.T) {
+	driver = &Driver{devicesMap: make(map[string]*Device)}
+
+	tests := []struct {
+		version      string
+		wantCommands []string
+	}{
+		{
+			version:      "3",
+			wantCommands: []string{"Version", "Write", "Write", "Read", "Read"},
+		},
+		{
+			version:      "4",
+			wantCommands: []string{"Version", "MultiWrite", "MultiRead"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run("v"+tt.version, func(t *testing.T) {
+			l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer l.Close()
+
+			commands := make([]string, 0, len(tt.wantCommands))
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				conn, err := net.Dial("tcp", l.Addr().String())
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				defer conn.Close()
+				fakeConnector(t, conn, tt.version, &commands)
+			}()
+
+			conn, err := l.AcceptTCP()
+			if err != nil {
+				t.Fatal(err)
+			}
+			d := NewDevice(conn, conn.RemoteAddr().String())
+			if err = d.CheckVersion(); err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.Background()
+			writes := []devices.MemoryWriteRequest{
+				{
+					RequestAddress: devices.AddressTuple{Address: 0x7E0100, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+					Data:           []byte{1, 2, 3, 4},
+				},
+				{
+					RequestAddress: devices.AddressTuple{Address: 0xF50200, AddressSpace: sni.AddressSpace_FxPakPro, MemoryMapping: sni.MemoryMapping_LoROM},
+					Data:           []byte{0xa5, 0x5a},
+				},
+			}
+			if _, err = d.MultiWriteMemory(ctx, writes...); err != nil {
+				t.Fatal(err)
+			}
+
+			rsps, err := d.MultiReadMemory(
+				ctx,
+				devices.MemoryReadRequest{RequestAddress: writes[0].RequestAddress, Size: len(writes[0].Data)},
+				devices.MemoryReadRequest{RequestAddress: writes[1].RequestAddress, Size: len(writes[1].Data)},
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := range rsps {
+				if !bytes.Equal(rsps[i].Data, writes[i].Data) {
+					t.Errorf("read[%d] = %x, want %x", i, rsps[i].Data, writes[i].Data)
+				}
+				if rsps[i].DeviceAddress.AddressSpace != sni.AddressSpace_SnesABus {
+					t.Errorf("read[%d] address space = %v, want %v", i, rsps[i].DeviceAddress.AddressSpace, sni.AddressSpace_SnesABus)
+				}
+			}
+
+			_ = d.Close()
+			<-done
+			if strings.Join(commands, ",") != strings.Join(tt.wantCommands, ",") {
+				t.Errorf("commands = %v, want %v", commands, tt.wantCommands)
+			}
+		})
+	}
+}
+
+func TestDevice_ListMemoryRegions(t *testing.T) {
+	driver = &Driver{devicesMap: make(map[string]*Device)}
+
+	l, err := net.ListenTCP("tcp", &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	go func() {
+		conn, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch line {
+			case "Version\n":
+				_, _ = fmt.Fprint(conn, "Version|SNI Connector|4|Bizhawk|EmulationState,MemoryDomains\n")
+			case "MemoryDomains\n":
+				_, _ = fmt.Fprint(conn, "MemoryDomains|WRAM:131072|CARTROM:1048576|CARTRAM:0|VRAM:65536|System Bus:16777216\n")
+			}
+		}
+	}()
+
+	conn, err := l.AcceptTCP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDevice(conn, conn.RemoteAddr().String())
+	defer d.Close()
+	if err = d.CheckVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	regions, err := d.ListMemoryRegions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the cart has no SRAM and domains that are not translated to are left out:
+	want := []devices.MemoryRegion{
+		{Name: "WRAM", Address: 0xF50000, AddressSpace: sni.AddressSpace_FxPakPro, Size: 0x20000, Readable: true, Writable: true},
+		{Name: "CARTROM", Address: 0x000000, AddressSpace: sni.AddressSpace_FxPakPro, Size: 0x100000, Readable: true, Writable: true},
+	}
+	if len(regions) != len(want) {
+		t.Fatalf("ListMemoryRegions() = %+v, want %+v", regions, want)
+	}
+	for i := range want {
+		if regions[i] != want[i] {
+			t.Errorf("ListMemoryRegions()[%d] = %+v, want %+v", i, regions[i], want[i])
+		}
+	}
+}
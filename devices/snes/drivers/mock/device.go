@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/alttpo/snes/timing"
 	"sni/devices"
+	"sni/devices/snes/mapping"
 	"sni/protos/sni"
 	"sni/util"
 	"sync"
@@ -106,6 +107,15 @@ func (d *Device) MultiWriteMemory(context context.Context, writes ...devices.Mem
 	return
 }
 
+func (d *Device) ListMemoryRegions(ctx context.Context) (regions []devices.MemoryRegion, err error) {
+	// Memory is laid out in the FX Pak Pro address space:
+	for _, memoryType := range []mapping.MemoryType{mapping.MemoryTypeROM, mapping.MemoryTypeSRAM, mapping.MemoryTypeWRAM} {
+		region, _ := mapping.MemoryRegionFor(memoryType, 0, true, true)
+		regions = append(regions, region)
+	}
+	return
+}
+
 func (d *Device) ResetSystem(ctx context.Context) error {
 	panic("implement me")
 }
@@ -121,3 +131,8 @@ func (d *Device) PauseUnpause(ctx context.Context, pausedState bool) (bool, erro
 func (d *Device) PauseToggle(ctx context.Context) error {
 	panic("implement me")
 }
+
+func (d *Device) GetEmulationState(ctx context.Context) (devices.EmulationStatus, error) {
+	// the frame counter never stops:
+	return devices.EmulationStatus{State: sni.EmulationState_Running}, nil
+}
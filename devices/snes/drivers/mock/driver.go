@@ -34,6 +34,8 @@ func (d *Driver) Kind() string { return "mock" }
 var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ReadMemory,
 	sni.DeviceCapability_WriteMemory,
+	sni.DeviceCapability_GetEmulationState,
+	sni.DeviceCapability_ListMemoryRegions,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -53,12 +55,8 @@ func (d *Driver) Detect() ([]devices.DeviceDescriptor, error) {
 	}, nil
 }
 
+// openDevice is called by the container with its lock held and only if the device is not open yet:
 func (d *Driver) openDevice(uri *url.URL) (devices.Device, error) {
-	dev, ok := d.container.GetDevice(d.DeviceKey(uri))
-	if ok {
-		return dev, nil
-	}
-
 	mock := &Device{}
 	mock.WRAM = mock.Memory[0xF50000:0xF70000]
 	mock.Init()
@@ -2,15 +2,18 @@ package retroarch
 
 import (
 	"fmt"
+	"github.com/alttpo/observable"
 	"github.com/alttpo/snes/timing"
+	"github.com/spf13/viper"
 	"log"
 	"net"
 	"net/url"
+	"os"
+	"sni/cmd/sni/config"
 	"sni/devices"
 	"sni/protos/sni"
 	"sni/util"
 	"sni/util/env"
-	"strings"
 	"sync"
 	"time"
 )
@@ -22,24 +25,85 @@ var driver *Driver
 
 const defaultAddressSpace = sni.AddressSpace_SnesABus
 
+// hostsConfigKey is the config.yaml key of the endpoints to detect RetroArch instances at; see devices.ParseEndpoints
+const hostsConfigKey = "retroarchHosts"
+
 type Driver struct {
 	container devices.DeviceContainer
 
-	detectors []*RAClient
+	detectorsLock sync.Mutex
+	detectors     []*RAClient
+	// display names of detector endpoints keyed by resolved address:
+	names map[string]string
 }
 
-func NewDriver(addresses []*net.UDPAddr) *Driver {
-	d := &Driver{
-		detectors: make([]*RAClient, len(addresses)),
-	}
+func NewDriver(endpoints []devices.Endpoint) *Driver {
+	d := &Driver{}
 	d.container = devices.NewDeviceDriverContainer(d.openDevice)
+	d.SetEndpoints(endpoints)
+
+	return d
+}
+
+// SetEndpoints replaces the endpoints to detect RetroArch instances at; detectors of endpoints that remain are kept.
+func (d *Driver) SetEndpoints(endpoints []devices.Endpoint) {
+	// resolve the addresses:
+	addresses := make([]*net.UDPAddr, 0, len(endpoints))
+	names := make(map[string]string, len(endpoints))
+	for _, endpoint := range endpoints {
+		addr, err := net.ResolveUDPAddr("udp", endpoint.Address)
+		if err != nil {
+			log.Printf("retroarch: resolve('%s'): %v\n", endpoint.Address, err)
+			// drop the address if it doesn't resolve; it is resolved again when the config changes:
+			continue
+		}
+		if _, ok := names[addr.String()]; ok {
+			continue
+		}
+
+		addresses = append(addresses, addr)
+		names[addr.String()] = endpoint.Name
+	}
 
+	defer d.detectorsLock.Unlock()
+	d.detectorsLock.Lock()
+
+	existing := make(map[string]*RAClient, len(d.detectors))
+	for _, detector := range d.detectors {
+		existing[detector.addr.String()] = detector
+	}
+
+	detectors := make([]*RAClient, len(addresses))
 	for i, addr := range addresses {
-		c := NewRAClient(addr, fmt.Sprintf("retroarch[%d]", i), timing.Frame*4)
-		d.detectors[i] = c
+		if detector, ok := existing[addr.String()]; ok {
+			detectors[i] = detector
+			delete(existing, addr.String())
+			continue
+		}
+		detectors[i] = NewRAClient(addr, fmt.Sprintf("retroarch[%d]", i), timing.Frame*4)
 	}
 
-	return d
+	// close detectors of removed endpoints:
+	for _, detector := range existing {
+		_ = detector.Close()
+	}
+
+	d.detectors = detectors
+	d.names = names
+}
+
+// replaceDetector swaps in a fresh detector for a closed one unless its endpoint was removed meanwhile:
+func (d *Driver) replaceDetector(old, c *RAClient) bool {
+	defer d.detectorsLock.Unlock()
+	d.detectorsLock.Lock()
+
+	for i := range d.detectors {
+		if d.detectors[i] == old {
+			d.detectors[i] = c
+			return true
+		}
+	}
+	return false
 }
 
 func (d *Driver) DisplayOrder() int {
@@ -63,6 +127,12 @@ var driverCapabilities = []sni.DeviceCapability{
 	sni.DeviceCapability_ResetSystem,
 	sni.DeviceCapability_PauseToggleEmulation,
 	sni.DeviceCapability_FetchFields,
+	sni.DeviceCapability_SaveState,
+	sni.DeviceCapability_Screenshot,
+	sni.DeviceCapability_FrameAdvance,
+	sni.DeviceCapability_SetEmulationSpeed,
+	sni.DeviceCapability_GetEmulationState,
+	sni.DeviceCapability_ListMemoryRegions,
 }
 
 func (d *Driver) HasCapabilities(capabilities ...sni.DeviceCapability) (bool, error) {
@@ -97,12 +167,17 @@ func (d *Driver) openDevice(uri *url.URL) (q devices.Device, err error) {
 }
 
 func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
+	d.detectorsLock.Lock()
+	detectors := append([]*RAClient(nil), d.detectors...)
+	names := d.names
+	d.detectorsLock.Unlock()
+
 	devicesLock := sync.Mutex{}
-	devs = make([]devices.DeviceDescriptor, 0, len(d.detectors))
+	devs = make([]devices.DeviceDescriptor, 0, len(detectors))
 
 	wg := sync.WaitGroup{}
-	wg.Add(len(d.detectors))
-	for i, de := range d.detectors {
+	wg.Add(len(detectors))
+	for i, de := range detectors {
 		// run detectors in parallel:
 		go func(i int, detector *RAClient) {
 			defer util.Recover()
@@ -115,7 +190,10 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 				detector.Close()
 				// refresh detector:
 				c := NewRAClient(detector.addr, fmt.Sprintf("retroarch[%d]", i), timing.Frame*4)
-				d.detectors[i] = c
+				if !d.replaceDetector(detector, c) {
+					// endpoint was removed:
+					return
+				}
 				c.MuteLog(true)
 				detector = c
 			}
@@ -131,7 +209,7 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 					}
 					return
 				}
-				if detector.DetectLoopback(d.detectors) {
+				if detector.DetectLoopback(detectors) {
 					detector.Close()
 					if logDetector {
 						log.Printf("retroarch: detect: detector[%d]: loopback connection detected; breaking\n", i)
@@ -153,9 +231,14 @@ func (d *Driver) Detect() (devs []devices.DeviceDescriptor, err error) {
 				return
 			}
 
+			displayName := fmt.Sprintf("RetroArch v%s (%s)", detector.Version(), detector.addr)
+			if name := names[detector.addr.String()]; name != "" {
+				displayName = fmt.Sprintf("%s - %s", name, displayName)
+			}
+
 			descriptor := devices.DeviceDescriptor{
 				Uri:                 url.URL{Scheme: driverName, Host: detector.addr.String()},
-				DisplayName:         fmt.Sprintf("RetroArch v%s (%s)", detector.version, detector.addr),
+				DisplayName:         displayName,
 				Kind:                d.Kind(),
 				Capabilities:        driverCapabilities[:],
 				DefaultAddressSpace: defaultAddressSpace,
@@ -198,38 +281,10 @@ func DriverInit() {
 		return
 	}
 
-	// comma-delimited list of host:port pairs:
-	hostsStr := env.GetOrSupply("SNI_RETROARCH_HOSTS", func() string {
-		// default network_cmd_port for RA is UDP 55355. we want to support connecting to multiple
-		// instances so let's auto-detect RA instances listening on UDP ports in the range
-		// [55355..55362]. realistically we probably won't be running any more than a few instances on
-		// the same machine at one time. i picked 8 since i currently have an 8-core CPU :)
-		var sb strings.Builder
-		const count = 1
-		for i := 0; i < count; i++ {
-			sb.WriteString(fmt.Sprintf("localhost:%d", 55355+i))
-			if i < count-1 {
-				sb.WriteByte(',')
-			}
-		}
-		return sb.String()
-	})
-
-	// split the hostsStr list by commas:
-	hosts := strings.Split(hostsStr, ",")
-
-	// resolve the addresses:
-	addresses := make([]*net.UDPAddr, 0, len(hosts))
-	for _, host := range hosts {
-		addr, err := net.ResolveUDPAddr("udp", host)
-		if err != nil {
-			log.Printf("retroarch: resolve('%s'): %v\n", host, err)
-			// drop the address if it doesn't resolve:
-			// TODO: consider retrying the resolve later? maybe not worth worrying about.
-			continue
-		}
-
-		addresses = append(addresses, addr)
+	// comma-delimited list of host:port pairs; overrides the config.yaml list:
+	envHosts = os.Getenv("SNI_RETROARCH_HOSTS")
+	if envHosts != "" {
+		log.Printf("retroarch: using SNI_RETROARCH_HOSTS='%s' instead of %s from config.yaml\n", envHosts, hostsConfigKey)
 	}
 
 	if util.IsTruthy(env.GetOrDefault("SNI_RETROARCH_DETECT_LOG", "0")) {
@@ -238,6 +293,49 @@ func DriverInit() {
 	}
 
 	// register the driver:
-	driver = NewDriver(addresses)
+	endpoints, err := configuredEndpoints(config.Config)
+	if err != nil {
+		log.Printf("retroarch: %v\n", err)
+	}
+	driver = NewDriver(endpoints)
 	devices.Register(driverName, driver)
+
+	// add and remove detectors as config.yaml changes:
+	if config.ConfigObservable != nil {
+		config.ConfigObservable.Subscribe(observable.NewObserver(driverName, func(event observable.Event) {
+			v, ok := event.Value.(*viper.Viper)
+			if !ok || v == nil {
+				return
+			}
+
+			endpoints, err := configuredEndpoints(v)
+			if err != nil {
+				// keep detecting at the previous endpoints until the config is fixed:
+				log.Printf("retroarch: %v\n", err)
+				return
+			}
+			driver.SetEndpoints(endpoints)
+		}))
+	}
+}
+
+// envHosts is the value of SNI_RETROARCH_HOSTS which overrides config.yaml if set:
+var envHosts string
+
+// configuredEndpoints returns the endpoints from SNI_RETROARCH_HOSTS, else from config.yaml, else the default
+// network command port on localhost.
+func configuredEndpoints(v *viper.Viper) (endpoints []devices.Endpoint, err error) {
+	var value interface{}
+	if envHosts != "" {
+		value = envHosts
+	} else if v.IsSet(hostsConfigKey) {
+		value = v.Get(hostsConfigKey)
+	} else {
+		// default network_cmd_port for RA is UDP 55355. to detect more instances on the same machine, list a port
+		// range like "localhost:55355-55362" in config.yaml.
+		value = "localhost:55355"
+	}
+
+	endpoints, err = devices.ParseEndpoints(value)
+	return
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"log"
 	"net"
 	"sni/cmd/sni/config"
@@ -13,7 +14,6 @@ import (
 	"sni/devices/snes/mapping"
 	"sni/protos/sni"
 	"sni/udpclient"
-	"sni/util"
 	"strconv"
 	"strings"
 	"sync"
@@ -48,7 +48,19 @@ type rwRequest struct {
 
 	Read  readOperation
 	Write writeOperation
-	R     chan<- error
+	R     chan<- *rwRequest
+	err   error
+
+	// encoded request datagram:
+	payload []byte
+	// WRITE_CORE_RAM is not answered:
+	noReply bool
+	// accepts the reply to commands that do not echo an address; nil for memory reads and writes:
+	match func(rsp []byte) bool
+	reply []byte
+
+	sentAt time.Time
+	sends  int
 }
 
 type RAClient struct {
@@ -59,13 +71,21 @@ type RAClient struct {
 
 	readWriteTimeout time.Duration
 
-	expectationLock  sync.Mutex
-	outgoing         chan *rwRequest
-	expectedIncoming chan *rwRequest
+	// requests awaiting their replies, see transport.go:
+	pendingLock sync.Mutex
+	conn        *net.UDPConn
+	inflight    map[requestKey]*rwRequest
+	stale       map[requestKey]*staleReplies
+	queued      []*rwRequest
+	done        chan struct{}
 
 	version string
 	useRCR  bool
 
+	// FAST_FORWARD and SLOWMOTION only toggle so remember what SNI turned on:
+	fastForward bool
+	slowMotion  bool
+
 	closeLock sync.Mutex
 	closed    bool
 }
@@ -90,14 +110,10 @@ func NewRAClient(addr *net.UDPAddr, name string, timeout time.Duration) *RAClien
 	c := &RAClient{
 		addr:             addr,
 		readWriteTimeout: timeout,
-		outgoing:         make(chan *rwRequest, 8),
-		expectedIncoming: make(chan *rwRequest, 8),
+		done:             make(chan struct{}),
 	}
 	udpclient.MakeUDPClient(name, &c.UDPClient)
 
-	go c.handleIncoming()
-	go c.handleOutgoing()
-
 	return c
 }
 
@@ -109,8 +125,8 @@ func (c *RAClient) Close() (err error) {
 
 	if !c.closed {
 		err = c.UDPClient.Close()
-		close(c.outgoing)
-		close(c.expectedIncoming)
+		close(c.done)
+		c.failAll(net.ErrClosed)
 		c.closed = true
 	}
 
@@ -122,6 +138,7 @@ func (c *RAClient) Connect(addr *net.UDPAddr) (err error) {
 		return
 	}
 
+	c.startTransport()
 	return
 }
 
@@ -171,7 +188,7 @@ func (c *RAClient) DetermineVersion() (err error) {
 	if logDetector {
 		log.Printf("retroarch: > %s", req)
 	}
-	rsp, err = c.WriteThenRead(req, time.Now().Add(c.readWriteTimeout))
+	rsp, err = c.sendCommandWaitReply(req, "VERSION", replyVersion, time.Now().Add(c.readWriteTimeout))
 	if err != nil {
 		return
 	}
@@ -251,7 +268,7 @@ func (d *RAClient) GetStatus(ctx context.Context) (raStatus, coreName, romFileNa
 	if config.VerboseLogging {
 		log.Printf("retroarch: > %s", req)
 	}
-	rsp, err = d.WriteThenRead(req, deadline)
+	rsp, err = d.sendCommandWaitReply(req, "GET_STATUS", replyPrefix("GET_STATUS "), deadline)
 	if err != nil {
 		return
 	}
@@ -259,35 +276,74 @@ func (d *RAClient) GetStatus(ctx context.Context) (raStatus, coreName, romFileNa
 		log.Printf("retroarch: < %s", rsp)
 	}
 
-	// parse the response:
-	var args string
-	_, err = fmt.Fscanf(bytes.NewReader(rsp), "GET_STATUS %s %s", &raStatus, &args)
-	if err != nil {
+	// parse the response; content names may contain spaces so take the rest of the line after the status:
+	line := strings.TrimRight(string(rsp), "\r\n")
+	fields := strings.SplitN(strings.TrimPrefix(line, "GET_STATUS "), " ", 2)
+	raStatus = fields[0]
+	if raStatus == "CONTENTLESS" {
+		// no core or content info follows:
 		return
 	}
-
-	// split the second arg by commas:
-	argsArr := strings.Split(args, ",")
-	if len(argsArr) >= 1 {
-		coreName = argsArr[0]
+	if len(fields) < 2 {
+		err = fmt.Errorf("retroarch: malformed GET_STATUS reply: `%s`", line)
+		return
 	}
-	if len(argsArr) >= 2 {
-		romFileName = argsArr[1]
+
+	// split the second arg by commas; the core name comes first and the crc32 last:
+	args := fields[1]
+	if i := strings.IndexByte(args, ','); i >= 0 {
+		coreName, args = args[:i], args[i+1:]
+	} else {
+		coreName, args = args, ""
 	}
-	if len(argsArr) >= 3 {
+	if i := strings.LastIndex(args, ",crc32="); i >= 0 {
 		// e.g. "crc32=dae58be6"
-		crc32 := argsArr[2]
-		if strings.HasPrefix(crc32, "crc32=") {
-			crc32 = crc32[len("crc32="):]
-
-			var crc32_u64 uint64
-			crc32_u64, err = strconv.ParseUint(crc32, 16, 32)
-			if err == nil {
-				romCRC32 = uint32(crc32_u64)
-			}
+		var crc32_u64 uint64
+		crc32_u64, err = strconv.ParseUint(args[i+len(",crc32="):], 16, 32)
+		if err != nil {
+			err = fmt.Errorf("retroarch: malformed GET_STATUS crc32: %w", err)
+			return
 		}
+		romCRC32 = uint32(crc32_u64)
+		args = args[:i]
+	}
+	romFileName = args
+
+	return
+}
+
+// configParams maps fields to the settings GET_CONFIG_PARAM reports for them:
+var configParams = map[sni.Field]string{
+	sni.Field_SaveFileDirectory:  "savefile_directory",
+	sni.Field_SaveStateDirectory: "savestate_directory",
+	sni.Field_SystemDirectory:    "system_directory",
+}
+
+// GetConfigParam reads a RetroArch setting, e.g. "system_directory". Versions before 1.9.1 do not know
+// GET_CONFIG_PARAM and never reply to it, so an empty value is returned for them instead.
+func (d *RAClient) GetConfigParam(ctx context.Context, name string) (value string, err error) {
+	if d.useRCR {
+		return
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(d.readWriteTimeout)
+	}
+
+	// replies echo the name so give each name its own key to request several at once:
+	prefix := "GET_CONFIG_PARAM " + name + " "
+	var rsp []byte
+	rsp, err = d.sendCommandWaitReply([]byte("GET_CONFIG_PARAM "+name+"\n"), prefix, replyPrefix(prefix), deadline)
+	if err != nil {
+		return
 	}
 
+	// e.g. "GET_CONFIG_PARAM system_directory /home/user/.config/retroarch/system"; paths may contain spaces:
+	value = strings.TrimRight(string(rsp[len(prefix):]), "\r\n")
+	if value == "unsupported" {
+		value = ""
+	}
 	return
 }
 
@@ -320,13 +376,31 @@ func (d *RAClient) FetchFields(ctx context.Context, fields ...sni.Field) (values
 			values = append(values, romFileName)
 			break
 		case sni.Field_RomHashType:
-			values = append(values, "crc32")
+			// GET_STATUS omits the crc32 without content:
+			if romCRC32 != 0 {
+				values = append(values, "crc32")
+			} else {
+				values = append(values, "")
+			}
 			break
 		case sni.Field_RomHashValue:
-			values = append(values, strconv.FormatUint(uint64(romCRC32), 16))
+			if romCRC32 != 0 {
+				values = append(values, fmt.Sprintf("%08x", romCRC32))
+			} else {
+				values = append(values, "")
+			}
+			break
+		case sni.Field_SaveFileDirectory, sni.Field_SaveStateDirectory, sni.Field_SystemDirectory:
+			var value string
+			value, err = d.GetConfigParam(ctx, configParams[field])
+			if err != nil {
+				return
+			}
+			values = append(values, value)
 			break
 		default:
-			// unknown value; append empty string to maintain index association:
+			// unknown value, e.g. CoreVersion and CorePlatform which RetroArch does not report; append empty string
+			// to maintain index association:
 			values = append(values, "")
 			break
 		}
@@ -335,6 +409,27 @@ func (d *RAClient) FetchFields(ctx context.Context, fields ...sni.Field) (values
 	return
 }
 
+// GetEmulationState maps GET_STATUS to the normalized emulation state.
+func (d *RAClient) GetEmulationState(ctx context.Context) (status devices.EmulationStatus, err error) {
+	var raStatus string
+	raStatus, status.CoreName, status.RomFileName, _, err = d.GetStatus(ctx)
+	if err != nil {
+		return
+	}
+
+	switch raStatus {
+	case "PLAYING":
+		status.State = sni.EmulationState_Running
+	case "PAUSED":
+		status.State = sni.EmulationState_Paused
+	case "CONTENTLESS":
+		status.State = sni.EmulationState_NoContent
+	default:
+		status.State = sni.EmulationState_StateUnknown
+	}
+	return
+}
+
 // RA 1.9.0 allows a maximum read size of 2723 bytes so we cut that off at 2048 to make division easier
 const maxReadSize = 2048
 
@@ -380,6 +475,24 @@ func (c *RAClient) RequiresMemoryMappingForAddress(ctx context.Context, address
 	return true, nil
 }
 
+func (c *RAClient) ListMemoryRegions(ctx context.Context) (regions []devices.MemoryRegion, err error) {
+	c.stateLock.Lock()
+	useRCR := c.useRCR
+	c.stateLock.Unlock()
+
+	// cores mark ROM descriptors as constant so WRITE_CORE_MEMORY refuses to write them; READ_CORE_RAM and
+	// WRITE_CORE_RAM only reach the WRAM and SRAM that the Cheevos system exposes:
+	memoryTypes := []mapping.MemoryType{mapping.MemoryTypeWRAM, mapping.MemoryTypeSRAM}
+	if !useRCR {
+		memoryTypes = append(memoryTypes, mapping.MemoryTypeROM)
+	}
+	for _, memoryType := range memoryTypes {
+		region, _ := mapping.MemoryRegionFor(memoryType, 0, true, memoryType != mapping.MemoryTypeROM)
+		regions = append(regions, region)
+	}
+	return
+}
+
 func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryReadRequest) (mrsp []devices.MemoryReadResponse, err error) {
 	deadline, ok := ctx.Deadline()
 	if !ok {
@@ -397,7 +510,7 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 				AddressSpace:  sni.AddressSpace_SnesABus,
 				MemoryMapping: read.RequestAddress.MemoryMapping,
 			},
-			Data: make([]byte, 0, read.Size),
+			Data: make([]byte, read.Size),
 		}
 
 		mrsp[j].DeviceAddress.Address, err = mapping.TranslateAddress(
@@ -433,7 +546,7 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
 					RequestSize:  maxReadSize,
-					ResponseData: rsp.Data,
+					ResponseData: rsp.Data[offs : offs : offs+maxReadSize],
 				},
 			})
 			offs += maxReadSize
@@ -458,43 +571,42 @@ func (c *RAClient) MultiReadMemory(ctx context.Context, reads ...devices.MemoryR
 						MemoryMapping: rsp.DeviceAddress.MemoryMapping,
 					},
 					RequestSize:  size,
-					ResponseData: rsp.Data,
+					ResponseData: rsp.Data[offs : offs : offs+uint32(size)],
 				},
 			})
 		}
 	}
 
-	// make a channel to receive response errors:
-	responses := make(chan error, len(outgoing))
-	defer close(responses)
+	// make a channel to receive completed requests in any order:
+	responses := make(chan *rwRequest, len(outgoing))
 
-	// fire off all commands:
+	// keep several commands in flight; each chunk reads into its own part of the response data:
 	for _, rwreq := range outgoing {
+		c.encode(rwreq)
 		rwreq.R = responses
-		c.outgoing <- rwreq
+		c.submit(rwreq)
 	}
 
 	// await all responses:
 	err = nil
-	for _, rwreq := range outgoing {
-		err = <-responses
+	for range outgoing {
+		rwreq := <-responses
+		err = rwreq.err
 		if err != nil {
 			if derr, ok := err.(*readResponseError); ok {
 				log.Printf("retroarch: read %#v returned error '%s'; filling response with $00\n", derr.Address, derr.Response)
 				// fill response with 00 bytes:
-				rwreq.Read.ResponseData = rwreq.Read.ResponseData[0:rwreq.Read.RequestSize]
-				d := rwreq.Read.ResponseData
+				d := rwreq.Read.ResponseData[0:rwreq.Read.RequestSize]
 				for i := range d {
 					d[i] = 0
 				}
 				err = nil
 			}
 			if err != nil {
+				c.cancel(outgoing)
 				return
 			}
 		}
-
-		mrsp[rwreq.index].Data = rwreq.Read.ResponseData
 	}
 
 	return
@@ -588,21 +700,23 @@ func (c *RAClient) MultiWriteMemory(ctx context.Context, writes ...devices.Memor
 		}
 	}
 
-	// make a channel to receive response errors:
-	responses := make(chan error, len(outgoing))
-	defer close(responses)
+	// make a channel to receive completed requests in any order:
+	responses := make(chan *rwRequest, len(outgoing))
 
-	// fire off all commands:
+	// keep several commands in flight:
 	for _, rwreq := range outgoing {
+		c.encode(rwreq)
 		rwreq.R = responses
-		c.outgoing <- rwreq
+		c.submit(rwreq)
 	}
 
 	// await all responses:
 	err = nil
-	for _, rwreq := range outgoing {
-		err = <-responses
+	for range outgoing {
+		rwreq := <-responses
+		err = rwreq.err
 		if err != nil {
+			c.cancel(outgoing)
 			return
 		}
 
@@ -612,88 +726,39 @@ func (c *RAClient) MultiWriteMemory(ctx context.Context, writes ...devices.Memor
 	return
 }
 
-func (c *RAClient) handleOutgoing() {
-	defer util.Recover()
-
+// encode builds the command datagram for a memory read or write:
+func (c *RAClient) encode(rwreq *rwRequest) {
 	c.stateLock.Lock()
 	useRCR := c.useRCR
 	c.stateLock.Unlock()
 
-	for rwreq := range c.outgoing {
-		var sb strings.Builder
-
-		// build the proper command to send:
-		if rwreq.isWrite {
-			// write:
-			rwreq.command = c.writeCommand()
-			rwreq.address = rwreq.Write.DeviceAddress.Address
-			_, _ = fmt.Fprintf(&sb, "%s %06x", rwreq.command, rwreq.address)
-
-			// emit hex data to write:
-			for _, v := range rwreq.Write.RequestData {
-				sb.WriteByte(' ')
-				sb.WriteByte(hextable[(v>>4)&0xF])
-				sb.WriteByte(hextable[v&0xF])
-			}
-			sb.WriteByte('\n')
-		} else {
-			// read:
-			rwreq.command = c.readCommand()
-			rwreq.address = rwreq.Read.DeviceAddress.Address
-			_, _ = fmt.Fprintf(&sb, "%s %06x %d\n", rwreq.command, rwreq.address, rwreq.Read.RequestSize)
-		}
-
-		// lock around sending the command and sending the expectation to read its response:
-		c.expectationLock.Lock()
-		{
-			reqStr := sb.String()
-
-			if config.VerboseLogging {
-				log.Printf("retroarch: > %s", reqStr)
-			}
-
-			err := c.WriteWithDeadline([]byte(reqStr), rwreq.deadline)
-			if err != nil {
-				c.expectationLock.Unlock()
-				rwreq.R <- err
-				if isCloseWorthy(err) {
-					_ = c.Close()
-				}
-				return
-			}
+	var sb strings.Builder
 
-			if useRCR && rwreq.isWrite {
-				// fake a response since we don't get any from WRITE_CORE_RAM:
-				rwreq.R <- nil
-			} else {
-				// we're now expecting an incoming response:
-				c.expectedIncoming <- rwreq
-			}
-		}
-		c.expectationLock.Unlock()
-	}
-}
-
-func (c *RAClient) handleIncoming() {
-	defer util.Recover()
-
-	for rwreq := range c.expectedIncoming {
-		rsp, err := c.ReadWithDeadline(rwreq.deadline)
-		if err != nil {
-			rwreq.R <- err
-			if isCloseWorthy(err) {
-				_ = c.Close()
-			}
-			break
-		}
-
-		if config.VerboseLogging {
-			log.Printf("retroarch: < %s", rsp)
+	// build the proper command to send:
+	if rwreq.isWrite {
+		// write:
+		rwreq.command = c.writeCommand()
+		rwreq.address = rwreq.Write.DeviceAddress.Address
+		_, _ = fmt.Fprintf(&sb, "%s %06x", rwreq.command, rwreq.address)
+
+		// emit hex data to write:
+		for _, v := range rwreq.Write.RequestData {
+			sb.WriteByte(' ')
+			sb.WriteByte(hextable[(v>>4)&0xF])
+			sb.WriteByte(hextable[v&0xF])
 		}
+		sb.WriteByte('\n')
 
-		err = c.parseCommandResponse(rsp, rwreq)
-		rwreq.R <- err
+		// we don't get any response from WRITE_CORE_RAM:
+		rwreq.noReply = useRCR
+	} else {
+		// read:
+		rwreq.command = c.readCommand()
+		rwreq.address = rwreq.Read.DeviceAddress.Address
+		_, _ = fmt.Fprintf(&sb, "%s %06x %d\n", rwreq.command, rwreq.address, rwreq.Read.RequestSize)
 	}
+
+	rwreq.payload = []byte(sb.String())
 }
 
 type readResponseError struct {
@@ -832,3 +897,91 @@ func (c *RAClient) PauseToggle(ctx context.Context) (err error) {
 	err = c.WriteWithDeadline(req, deadline)
 	return
 }
+
+// SaveState saves to a numbered state slot with the SAVE_STATE_SLOT network command. RetroArch cannot save states
+// to arbitrary paths or transfer them over the network.
+func (c *RAClient) SaveState(ctx context.Context, target devices.SaveStateTarget) (state []byte, err error) {
+	if target.Path != "" || target.Transfer {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("retroarch: only save state slots are supported"))
+		return
+	}
+
+	err = c.writeCommandLine(ctx, fmt.Sprintf("SAVE_STATE_SLOT %d\n", target.Slot))
+	return
+}
+
+// LoadState loads from a numbered state slot with the LOAD_STATE_SLOT network command.
+func (c *RAClient) LoadState(ctx context.Context, target devices.SaveStateTarget) (err error) {
+	if target.Path != "" || target.Transfer {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("retroarch: only save state slots are supported"))
+		return
+	}
+
+	err = c.writeCommandLine(ctx, fmt.Sprintf("LOAD_STATE_SLOT %d\n", target.Slot))
+	return
+}
+
+// writeCommandLine sends a network command that RetroArch does not reply to:
+func (c *RAClient) writeCommandLine(ctx context.Context, cmd string) (err error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.readWriteTimeout)
+	}
+
+	req := []byte(cmd)
+	if config.VerboseLogging {
+		log.Printf("retroarch: > %s", req)
+	}
+	err = c.WriteWithDeadline(req, deadline)
+	return
+}
+
+// Screenshot sends the SCREENSHOT network command which saves a screenshot to RetroArch's configured screenshot
+// directory. RetroArch neither replies with the file name nor transfers the image so the directory is returned as path.
+func (c *RAClient) Screenshot(ctx context.Context) (image []byte, mimeType string, path string, err error) {
+	err = c.writeCommandLine(ctx, "SCREENSHOT\n")
+	if err != nil {
+		return
+	}
+
+	path, err = c.GetConfigParam(ctx, "screenshot_directory")
+	return
+}
+
+// FrameAdvance sends the FRAMEADVANCE network command which pauses RetroArch and advances a single frame. RetroArch
+// handles all pending network commands at once and does not report when a frame was advanced, so more frames cannot
+// be advanced reliably.
+func (c *RAClient) FrameAdvance(ctx context.Context, frames int) (err error) {
+	if frames > 1 {
+		err = devices.WithCode(codes.Unimplemented, fmt.Errorf("retroarch: can only advance a single frame at a time"))
+		return
+	}
+
+	err = c.writeCommandLine(ctx, "FRAMEADVANCE\n")
+	return
+}
+
+// SetEmulationSpeed toggles FAST_FORWARD and SLOWMOTION which run at the rates configured in RetroArch. The toggle
+// state is only known for changes made through this client.
+func (c *RAClient) SetEmulationSpeed(ctx context.Context, speed float64) (err error) {
+	defer c.stateLock.Unlock()
+	c.stateLock.Lock()
+
+	if wantFastForward := speed > 1; c.fastForward != wantFastForward {
+		err = c.writeCommandLine(ctx, "FAST_FORWARD\n")
+		if err != nil {
+			return
+		}
+		c.fastForward = wantFastForward
+	}
+
+	if wantSlowMotion := speed < 1; c.slowMotion != wantSlowMotion {
+		err = c.writeCommandLine(ctx, "SLOWMOTION\n")
+		if err != nil {
+			return
+		}
+		c.slowMotion = wantSlowMotion
+	}
+
+	return
+}
@@ -0,0 +1,285 @@
+package retroarch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/alttpo/snes/timing"
+	"google.golang.org/grpc/codes"
+	"net"
+	"reflect"
+	"sni/devices"
+	"sni/protos/sni"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRAClient_MultiReadMemoryLossyTransport(t *testing.T) {
+	var lock sync.Mutex
+	received := make(map[uint32]int)
+
+	c := connectFakeRetroArch(t, func(req string) (replies []string, delay time.Duration) {
+		if strings.HasPrefix(req, "VERSION") {
+			return []string{"1.9.10\n"}, 0
+		}
+
+		var addr uint32
+		var size int
+		if n, _ := fmt.Sscanf(req, "READ_CORE_MEMORY %x %d", &addr, &size); n != 2 {
+			return nil, 0
+		}
+
+		lock.Lock()
+		received[addr]++
+		attempt := received[addr]
+		lock.Unlock()
+
+		// lose the first reply to every third chunk:
+		if attempt == 1 && (addr/maxReadSize)%3 == 0 {
+			return nil, 0
+		}
+
+		var sb strings.Builder
+		_, _ = fmt.Fprintf(&sb, "READ_CORE_MEMORY %06x", addr)
+		for i := 0; i < size; i++ {
+			_, _ = fmt.Fprintf(&sb, " %02x", byte(addr+uint32(i)))
+		}
+		sb.WriteByte('\n')
+
+		// duplicate every reply and delay some to reorder them:
+		return []string{sb.String(), sb.String()}, time.Duration(addr/maxReadSize%4) * time.Millisecond
+	})
+
+	if err := c.DetermineVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	const size = 0x10000
+	mrsp, err := c.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+		RequestAddress: devices.AddressTuple{
+			Address:       0x7E0000,
+			AddressSpace:  sni.AddressSpace_SnesABus,
+			MemoryMapping: sni.MemoryMapping_LoROM,
+		},
+		Size: size,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := mrsp[0].Data
+	if len(data) != size {
+		t.Fatalf("len(Data) = %d, want %d", len(data), size)
+	}
+	for i := range data {
+		if want := byte(0x7E0000 + i); data[i] != want {
+			t.Fatalf("Data[%#x] = %#02x, want %#02x", i, data[i], want)
+		}
+	}
+}
+
+func TestRAClient_ReadMemoryLateDuplicates(t *testing.T) {
+	var lock sync.Mutex
+	value := byte(0x11)
+	attempts := 0
+
+	c := connectFakeRetroArch(t, func(req string) (replies []string, delay time.Duration) {
+		if strings.HasPrefix(req, "VERSION") {
+			return []string{"1.9.10\n"}, 0
+		}
+
+		var addr uint32
+		var size int
+		if n, _ := fmt.Sscanf(req, "READ_CORE_MEMORY %x %d", &addr, &size); n != 2 {
+			return nil, 0
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		attempts++
+		reply := fmt.Sprintf("READ_CORE_MEMORY %06x %02x\n", addr, value)
+
+		switch attempts {
+		case 1:
+			// the reply to the first read is so late that the read is sent again and completes without it:
+			return []string{reply}, retransmitInterval + timing.Frame*2
+		case 2:
+			return []string{reply}, 0
+		default:
+			// the second read is answered after the late reply to the first arrived:
+			return []string{reply}, timing.Frame * 2
+		}
+	})
+
+	if err := c.DetermineVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	read := func() byte {
+		mrsp, err := c.MultiReadMemory(context.Background(), devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       0x7E0010,
+				AddressSpace:  sni.AddressSpace_SnesABus,
+				MemoryMapping: sni.MemoryMapping_LoROM,
+			},
+			Size: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return mrsp[0].Data[0]
+	}
+
+	if got := read(); got != 0x11 {
+		t.Fatalf("first read = %#02x, want %#02x", got, 0x11)
+	}
+
+	// the late reply to the first read must not answer the second:
+	lock.Lock()
+	value = 0x22
+	lock.Unlock()
+	if got := read(); got != 0x22 {
+		t.Fatalf("second read = %#02x, want %#02x", got, 0x22)
+	}
+}
+
+// connectFakeRetroArch starts a RetroArch network command server on the loopback interface that answers each request
+// datagram with the replies returned by handle after delay, and connects an RAClient to it.
+func connectFakeRetroArch(t *testing.T, handle func(req string) (replies []string, delay time.Duration)) *RAClient {
+	server, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = server.Close() })
+
+	go func() {
+		b := make([]byte, 65536)
+		for {
+			n, from, err := server.ReadFromUDP(b)
+			if err != nil {
+				return
+			}
+
+			replies, delay := handle(string(b[:n]))
+			go func() {
+				time.Sleep(delay)
+				for _, reply := range replies {
+					_, _ = server.WriteToUDP([]byte(reply), from)
+				}
+			}()
+		}
+	}()
+
+	addr := server.LocalAddr().(*net.UDPAddr)
+	c := NewRAClient(addr, "fake", time.Second*5)
+	c.MuteLog(true)
+	if err = c.Connect(addr); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+
+	return c
+}
+
+func TestRAClient_FetchFields(t *testing.T) {
+	c := connectFakeRetroArch(t, func(req string) (replies []string, delay time.Duration) {
+		switch strings.TrimSpace(req) {
+		case "VERSION":
+			return []string{"1.9.10\n"}, 0
+		case "GET_STATUS":
+			return []string{"GET_STATUS PAUSED bsnes-mercury,Zelda no Densetsu, Kamigami no Triforce (J),crc32=0ae58be6\n"}, 0
+		case "GET_CONFIG_PARAM system_directory":
+			return []string{"GET_CONFIG_PARAM system_directory /home/link/RetroArch Data/system\n"}, 0
+		}
+		return nil, 0
+	})
+
+	if err := c.DetermineVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	values, err := c.FetchFields(
+		context.Background(),
+		sni.Field_DeviceVersion,
+		sni.Field_DeviceStatus,
+		sni.Field_CoreName,
+		sni.Field_CoreVersion,
+		sni.Field_RomFileName,
+		sni.Field_RomHashType,
+		sni.Field_RomHashValue,
+		sni.Field_SystemDirectory,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"1.9.10",
+		"PAUSED",
+		"bsnes-mercury",
+		"",
+		"Zelda no Densetsu, Kamigami no Triforce (J)",
+		"crc32",
+		"0ae58be6",
+		"/home/link/RetroArch Data/system",
+	}
+	if !reflect.DeepEqual(values, want) {
+		t.Fatalf("FetchFields() = %q, want %q", values, want)
+	}
+}
+
+func TestRAClient_FrameAdvance(t *testing.T) {
+	var lock sync.Mutex
+	advances := 0
+	c := connectFakeRetroArch(t, func(req string) (replies []string, delay time.Duration) {
+		if strings.TrimSpace(req) == "FRAMEADVANCE" {
+			lock.Lock()
+			advances++
+			lock.Unlock()
+		}
+		return nil, 0
+	})
+
+	if err := c.FrameAdvance(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// RetroArch would collapse several FRAMEADVANCE commands into one:
+	var coded *devices.CodedError
+	if err := c.FrameAdvance(context.Background(), 2); !errors.As(err, &coded) || coded.Code != codes.Unimplemented {
+		t.Fatalf("FrameAdvance(2) error = %v, want code %v", err, codes.Unimplemented)
+	}
+
+	time.Sleep(time.Millisecond * 50)
+	lock.Lock()
+	defer lock.Unlock()
+	if advances != 1 {
+		t.Errorf("sent %d FRAMEADVANCE commands, want 1", advances)
+	}
+}
+
+func TestRAClient_Screenshot(t *testing.T) {
+	c := connectFakeRetroArch(t, func(req string) (replies []string, delay time.Duration) {
+		switch strings.TrimSpace(req) {
+		case "VERSION":
+			return []string{"1.9.10\n"}, 0
+		case "GET_CONFIG_PARAM screenshot_directory":
+			return []string{"GET_CONFIG_PARAM screenshot_directory /home/link/RetroArch Data/screenshots\n"}, 0
+		}
+		return nil, 0
+	})
+
+	if err := c.DetermineVersion(); err != nil {
+		t.Fatal(err)
+	}
+
+	image, _, path, err := c.Screenshot(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image != nil || path != "/home/link/RetroArch Data/screenshots" {
+		t.Errorf("Screenshot() = (%d bytes, %q), want the screenshot directory", len(image), path)
+	}
+}
@@ -0,0 +1,413 @@
+package retroarch
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/alttpo/snes/timing"
+	"log"
+	"net"
+	"sni/cmd/sni/config"
+	"sni/util"
+	"time"
+)
+
+// RetroArch polls for network commands once per frame and answers each command with a single datagram; replies to
+// memory commands start with the command and address they answer. The transport keeps several requests in flight,
+// matches replies to requests by command and address instead of by order, and sends requests again whose replies
+// were lost. Since replies carry no request id, a request that was sent more than once may still be answered after it
+// completed; the next request with the same command and address is held back until those late replies arrived or
+// staleReplyWindow passed so that it cannot claim them.
+
+// maxInFlight limits the requests awaiting replies so that RetroArch's replies do not overflow the socket receive
+// buffer; 8 maximum size read replies are about 48KiB:
+const maxInFlight = 8
+
+// retransmitInterval is how long to wait for a reply before sending the request again:
+const retransmitInterval = timing.Frame * 3
+
+// staleReplyWindow is how long to wait for late replies to a completed request before giving up on them:
+const staleReplyWindow = retransmitInterval * 4
+
+type requestKey struct {
+	command string
+	address uint32
+}
+
+// staleReplies counts the replies still expected for requests that completed without them:
+type staleReplies struct {
+	count int
+	match func(rsp []byte) bool
+	until time.Time
+}
+
+func (r *rwRequest) key() requestKey {
+	return requestKey{r.command, r.address}
+}
+
+func (r *rwRequest) String() string {
+	if r.match != nil {
+		return r.command
+	}
+	return fmt.Sprintf("%s %06x", r.command, r.address)
+}
+
+// replyPrefix matches replies to commands that echo the command but no address, e.g. GET_STATUS:
+func replyPrefix(prefix string) func(rsp []byte) bool {
+	return func(rsp []byte) bool {
+		return bytes.HasPrefix(rsp, []byte(prefix))
+	}
+}
+
+// replyVersion matches replies to VERSION which consist of only the version number:
+func replyVersion(rsp []byte) bool {
+	return len(rsp) > 0 && rsp[0] >= '0' && rsp[0] <= '9'
+}
+
+// startTransport starts the goroutines that receive replies and retransmit requests once connected:
+func (c *RAClient) startTransport() {
+	conn := c.Conn()
+
+	c.pendingLock.Lock()
+	c.conn = conn
+	c.inflight = make(map[requestKey]*rwRequest)
+	c.stale = make(map[requestKey]*staleReplies)
+	c.pendingLock.Unlock()
+
+	go c.handleIncoming(conn)
+	go c.handleRetransmits()
+}
+
+// submit queues rwreq to be sent as soon as there is room in flight and no other request with the same command and
+// address awaits its reply, so that a reply can only ever match one request. The request is sent to rwreq.R once it
+// completes with rwreq.err set on failure.
+func (c *RAClient) submit(rwreq *rwRequest) {
+	defer c.pendingLock.Unlock()
+	c.pendingLock.Lock()
+
+	if c.inflight == nil {
+		// not connected or already closed:
+		rwreq.err = net.ErrClosed
+		rwreq.R <- rwreq
+		return
+	}
+
+	c.queued = append(c.queued, rwreq)
+	c.pump()
+}
+
+// sendCommandWaitReply sends a command that is not a memory read or write and waits for the reply that match accepts,
+// sending the command again until deadline if its reply is lost.
+func (c *RAClient) sendCommandWaitReply(req []byte, command string, match func(rsp []byte) bool, deadline time.Time) (rsp []byte, err error) {
+	responses := make(chan *rwRequest, 1)
+	rwreq := &rwRequest{
+		deadline: deadline,
+		command:  command,
+		payload:  req,
+		match:    match,
+		R:        responses,
+	}
+
+	c.submit(rwreq)
+	<-responses
+
+	rsp, err = rwreq.reply, rwreq.err
+	return
+}
+
+// cancel forgets requests that are no longer awaited so their replies are dropped:
+func (c *RAClient) cancel(rwreqs []*rwRequest) {
+	defer c.pendingLock.Unlock()
+	c.pendingLock.Lock()
+
+	for _, rwreq := range rwreqs {
+		if c.inflight[rwreq.key()] == rwreq {
+			delete(c.inflight, rwreq.key())
+			c.expectStale(rwreq, rwreq.sends)
+		}
+	}
+
+	queued := c.queued[:0]
+	for _, rwreq := range c.queued {
+		if !containsRequest(rwreqs, rwreq) {
+			queued = append(queued, rwreq)
+		}
+	}
+	c.setQueued(queued)
+
+	c.pump()
+}
+
+func containsRequest(rwreqs []*rwRequest, rwreq *rwRequest) bool {
+	for _, r := range rwreqs {
+		if r == rwreq {
+			return true
+		}
+	}
+	return false
+}
+
+// setQueued replaces the queue with a prefix of itself, clearing the rest so completed requests can be collected;
+// pendingLock must be held.
+func (c *RAClient) setQueued(queued []*rwRequest) {
+	for i := len(queued); i < len(c.queued); i++ {
+		c.queued[i] = nil
+	}
+	c.queued = queued
+}
+
+// pump sends queued requests in order while there is room in flight; pendingLock must be held.
+func (c *RAClient) pump() {
+	queued := c.queued[:0]
+	for _, rwreq := range c.queued {
+		if len(c.inflight) >= maxInFlight {
+			queued = append(queued, rwreq)
+			continue
+		}
+		if _, busy := c.inflight[rwreq.key()]; busy || c.awaitingStale(rwreq.key()) {
+			queued = append(queued, rwreq)
+			continue
+		}
+
+		if err := c.send(rwreq); err != nil {
+			rwreq.err = err
+			rwreq.R <- rwreq
+			continue
+		}
+		if rwreq.noReply {
+			rwreq.R <- rwreq
+			continue
+		}
+
+		c.inflight[rwreq.key()] = rwreq
+	}
+	c.setQueued(queued)
+}
+
+// send writes the request datagram to the connection captured by startTransport which, unlike the UDPClient's,
+// stays valid after Close; pendingLock must be held.
+func (c *RAClient) send(rwreq *rwRequest) (err error) {
+	if c.conn == nil {
+		return net.ErrClosed
+	}
+
+	if config.VerboseLogging {
+		log.Printf("retroarch: > %s", rwreq.payload)
+	}
+
+	err = c.conn.SetWriteDeadline(rwreq.deadline)
+	if err != nil {
+		return
+	}
+	_, err = c.conn.Write(rwreq.payload)
+	rwreq.sentAt = time.Now()
+	rwreq.sends++
+	return
+}
+
+// handleIncoming completes the requests that received datagrams answer until conn is closed. Duplicate and late
+// datagrams answer no request in flight and are dropped.
+func (c *RAClient) handleIncoming(conn *net.UDPConn) {
+	defer util.Recover()
+
+	b := make([]byte, 65536)
+	for {
+		n, _, err := conn.ReadFromUDP(b)
+		if err != nil {
+			c.failAll(c.FatalError(err))
+			_ = c.Close()
+			return
+		}
+
+		// the buffer is reused for the next datagram:
+		rsp := make([]byte, n)
+		copy(rsp, b[:n])
+
+		if config.VerboseLogging {
+			log.Printf("retroarch: < %s", rsp)
+		}
+
+		c.pendingLock.Lock()
+		rwreq := c.claim(rsp)
+		if rwreq != nil {
+			c.pump()
+		}
+		c.pendingLock.Unlock()
+
+		if rwreq == nil {
+			if config.VerboseLogging {
+				log.Printf("retroarch: dropping reply that matches no request in flight\n")
+			}
+			continue
+		}
+
+		if rwreq.match != nil {
+			rwreq.reply = rsp
+		} else {
+			rwreq.err = c.parseCommandResponse(rsp, rwreq)
+		}
+		rwreq.R <- rwreq
+	}
+}
+
+// claim removes and returns the request in flight that rsp answers; pendingLock must be held. Late replies to
+// completed requests answer no request.
+func (c *RAClient) claim(rsp []byte) *rwRequest {
+	var cmd string
+	var addr uint32
+	if n, _ := fmt.Fscanf(bytes.NewReader(rsp), "%s %x", &cmd, &addr); n == 2 {
+		key := requestKey{cmd, addr}
+		if s, ok := c.stale[key]; ok && s.match == nil {
+			c.dropStale(key, s)
+			return nil
+		}
+		if rwreq, ok := c.inflight[key]; ok && rwreq.match == nil {
+			delete(c.inflight, key)
+			c.expectStale(rwreq, rwreq.sends-1)
+			return rwreq
+		}
+	}
+
+	for key, s := range c.stale {
+		if s.match != nil && s.match(rsp) {
+			c.dropStale(key, s)
+			return nil
+		}
+	}
+
+	for key, rwreq := range c.inflight {
+		if rwreq.match != nil && rwreq.match(rsp) {
+			delete(c.inflight, key)
+			c.expectStale(rwreq, rwreq.sends-1)
+			return rwreq
+		}
+	}
+
+	return nil
+}
+
+// expectStale records that count more replies to rwreq may still arrive after it is no longer in flight; pendingLock
+// must be held.
+func (c *RAClient) expectStale(rwreq *rwRequest, count int) {
+	if count <= 0 || rwreq.noReply {
+		return
+	}
+
+	s, ok := c.stale[rwreq.key()]
+	if !ok {
+		s = &staleReplies{match: rwreq.match}
+		c.stale[rwreq.key()] = s
+	}
+	s.count += count
+	s.until = time.Now().Add(staleReplyWindow)
+}
+
+// dropStale accounts for a late reply that arrived; pendingLock must be held.
+func (c *RAClient) dropStale(key requestKey, s *staleReplies) {
+	if config.VerboseLogging {
+		log.Printf("retroarch: dropping late reply to an earlier `%s`\n", key.command)
+	}
+
+	s.count--
+	if s.count <= 0 {
+		delete(c.stale, key)
+	}
+}
+
+// awaitingStale reports whether late replies to an earlier request with key may still arrive; pendingLock must be
+// held.
+func (c *RAClient) awaitingStale(key requestKey) bool {
+	s, ok := c.stale[key]
+	if !ok {
+		return false
+	}
+	if time.Now().After(s.until) {
+		// the late replies were lost:
+		delete(c.stale, key)
+		return false
+	}
+	return true
+}
+
+// handleRetransmits sends requests again whose replies are overdue and fails requests past their deadline:
+func (c *RAClient) handleRetransmits() {
+	defer util.Recover()
+
+	ticker := time.NewTicker(timing.Frame)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.done:
+			return
+		case now := <-ticker.C:
+			c.retransmit(now)
+		}
+	}
+}
+
+func (c *RAClient) retransmit(now time.Time) {
+	defer c.pendingLock.Unlock()
+	c.pendingLock.Lock()
+
+	for key, rwreq := range c.inflight {
+		if now.After(rwreq.deadline) {
+			delete(c.inflight, key)
+			c.expectStale(rwreq, rwreq.sends)
+			rwreq.err = c.FatalError(fmt.Errorf("no reply to `%s` after %d attempts", rwreq, rwreq.sends))
+			rwreq.R <- rwreq
+			continue
+		}
+		if now.Sub(rwreq.sentAt) < retransmitInterval {
+			continue
+		}
+
+		if err := c.send(rwreq); err != nil {
+			delete(c.inflight, key)
+			rwreq.err = err
+			rwreq.R <- rwreq
+		}
+	}
+
+	for key, s := range c.stale {
+		if now.After(s.until) {
+			delete(c.stale, key)
+		}
+	}
+
+	queued := c.queued[:0]
+	for _, rwreq := range c.queued {
+		if now.After(rwreq.deadline) {
+			rwreq.err = c.FatalError(fmt.Errorf("`%s` was not sent before its deadline", rwreq))
+			rwreq.R <- rwreq
+			continue
+		}
+		queued = append(queued, rwreq)
+	}
+	c.setQueued(queued)
+
+	c.pump()
+}
+
+// failAll completes every outstanding request with err and refuses new requests:
+func (c *RAClient) failAll(err error) {
+	defer c.pendingLock.Unlock()
+	c.pendingLock.Lock()
+
+	if c.inflight == nil {
+		return
+	}
+
+	for _, rwreq := range c.inflight {
+		rwreq.err = err
+		rwreq.R <- rwreq
+	}
+	for _, rwreq := range c.queued {
+		rwreq.err = err
+		rwreq.R <- rwreq
+	}
+
+	c.conn = nil
+	c.inflight = nil
+	c.stale = nil
+	c.setQueued(nil)
+}
@@ -0,0 +1,41 @@
+package mapping
+
+import "github.com/alttpo/snes"
+
+// Chips names the enhancement chips declared by a ROM header's cartridge type ($FFD6) and, for custom chips, its
+// coprocessor type ($FFBF):
+func Chips(header *snes.Header) (names []string) {
+	names = make([]string, 0, 1)
+
+	// $x0..$x2 are ROM, RAM and battery combinations without a coprocessor:
+	if header.CartridgeType&0x0F < 0x03 {
+		return
+	}
+
+	switch header.CartridgeType >> 4 {
+	case 0x0:
+		names = append(names, "DSP")
+	case 0x1:
+		names = append(names, "SuperFX")
+	case 0x2:
+		names = append(names, "OBC1")
+	case 0x3:
+		names = append(names, "SA-1")
+	case 0x4:
+		names = append(names, "S-DD1")
+	case 0x5:
+		names = append(names, "S-RTC")
+	case 0xF:
+		switch header.CoCPUType {
+		case 0x00:
+			names = append(names, "SPC7110")
+		case 0x01:
+			names = append(names, "ST010")
+		case 0x02:
+			names = append(names, "ST018")
+		case 0x10:
+			names = append(names, "CX4")
+		}
+	}
+	return
+}
@@ -0,0 +1,29 @@
+package mapping
+
+import (
+	"github.com/alttpo/snes"
+	"strings"
+	"testing"
+)
+
+func TestChips(t *testing.T) {
+	tests := []struct {
+		cartridgeType byte
+		coCPUType     byte
+		want          string
+	}{
+		{cartridgeType: 0x00, want: ""},
+		{cartridgeType: 0x02, want: ""},
+		{cartridgeType: 0x03, want: "DSP"},
+		{cartridgeType: 0x1A, want: "SuperFX"},
+		{cartridgeType: 0x35, want: "SA-1"},
+		{cartridgeType: 0xF5, coCPUType: 0x00, want: "SPC7110"},
+		{cartridgeType: 0xF3, coCPUType: 0x10, want: "CX4"},
+	}
+	for _, tt := range tests {
+		header := snes.Header{CartridgeType: tt.cartridgeType, CoCPUType: tt.coCPUType}
+		if got := strings.Join(Chips(&header), ","); got != tt.want {
+			t.Errorf("Chips($%02x, $%02x) = %q, want %q", tt.cartridgeType, tt.coCPUType, got, tt.want)
+		}
+	}
+}
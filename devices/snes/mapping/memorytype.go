@@ -40,6 +40,9 @@ func MemoryTypeFor(a devices.AddressTuple) (memoryType MemoryType, pakAddress ui
 	case sni.AddressSpace_Raw:
 		err = ErrUnknownMapping
 		break
+	case sni.AddressSpace_FxPakProMSU, sni.AddressSpace_FxPakProConfig:
+		err = ErrUntranslatableSpace
+		break
 	}
 
 	if err != nil {
@@ -67,3 +70,44 @@ func MemoryTypeForPakAddress(pakAddress uint32) (memoryType MemoryType, offset u
 	}
 	return
 }
+
+// memoryTypeRegions are the FX Pak Pro address space regions that MemoryTypeForPakAddress maps to each memory type:
+var memoryTypeRegions = map[MemoryType]struct{ start, size uint32 }{
+	MemoryTypeROM:  {0x00_0000, 0xE0_0000},
+	MemoryTypeSRAM: {0xE0_0000, 0x10_0000},
+	MemoryTypeWRAM: {0xF5_0000, 0x02_0000},
+}
+
+// PakAddressForMemoryType is the inverse of MemoryTypeForPakAddress; size is the size of the memory type's region.
+// ok is false for unknown memory types and offsets outside the region.
+func PakAddressForMemoryType(memoryType MemoryType, offset uint32) (pakAddress uint32, size uint32, ok bool) {
+	region, known := memoryTypeRegions[memoryType]
+	if !known || offset >= region.size {
+		return
+	}
+
+	pakAddress, size, ok = region.start+offset, region.size, true
+	return
+}
+
+// MemoryRegionFor describes the FX Pak Pro address space region that memoryType is read and written through. size is
+// clamped to the size of the region and defaults to it if 0. ok is false for memory types without a region.
+func MemoryRegionFor(memoryType MemoryType, size uint32, readable, writable bool) (region devices.MemoryRegion, ok bool) {
+	r, known := memoryTypeRegions[memoryType]
+	if !known {
+		return
+	}
+	if size == 0 || size > r.size {
+		size = r.size
+	}
+
+	region, ok = devices.MemoryRegion{
+		Name:         string(memoryType),
+		Address:      r.start,
+		AddressSpace: sni.AddressSpace_FxPakPro,
+		Size:         size,
+		Readable:     readable,
+		Writable:     writable,
+	}, true
+	return
+}
@@ -0,0 +1,59 @@
+package mapping
+
+import (
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestPakAddressForMemoryType(t *testing.T) {
+	for _, memoryType := range []MemoryType{MemoryTypeROM, MemoryTypeSRAM, MemoryTypeWRAM} {
+		for _, offset := range []uint32{0, 0x1FFFF} {
+			pakAddress, _, ok := PakAddressForMemoryType(memoryType, offset)
+			if !ok {
+				t.Fatalf("PakAddressForMemoryType(%s, %#x) not ok", memoryType, offset)
+			}
+
+			// round trip through MemoryTypeFor:
+			gotType, _, gotOffset := MemoryTypeFor(devices.AddressTuple{
+				Address:      pakAddress,
+				AddressSpace: sni.AddressSpace_FxPakPro,
+			})
+			if gotType != memoryType || gotOffset != offset {
+				t.Errorf("MemoryTypeFor($%06x) = (%s, %#x), want (%s, %#x)", pakAddress, gotType, gotOffset, memoryType, offset)
+			}
+		}
+	}
+
+	if _, _, ok := PakAddressForMemoryType(MemoryTypeWRAM, 0x20000); ok {
+		t.Errorf("PakAddressForMemoryType(WRAM, $20000) ok, want out of range")
+	}
+	if _, _, ok := PakAddressForMemoryType("VRAM", 0); ok {
+		t.Errorf("PakAddressForMemoryType(VRAM, 0) ok, want unknown")
+	}
+}
+
+func TestMemoryRegionFor(t *testing.T) {
+	region, ok := MemoryRegionFor(MemoryTypeSRAM, 0x2000, true, false)
+	want := devices.MemoryRegion{
+		Name:         "SRAM",
+		Address:      0xE0_0000,
+		AddressSpace: sni.AddressSpace_FxPakPro,
+		Size:         0x2000,
+		Readable:     true,
+	}
+	if !ok || region != want {
+		t.Errorf("MemoryRegionFor(SRAM, $2000) = (%+v, %v), want (%+v, true)", region, ok, want)
+	}
+
+	// sizes default to and are clamped to the size of the region:
+	for _, size := range []uint32{0, 0x30000} {
+		if region, _ = MemoryRegionFor(MemoryTypeWRAM, size, true, true); region.Size != 0x20000 {
+			t.Errorf("MemoryRegionFor(WRAM, %#x).Size = %#x, want $20000", size, region.Size)
+		}
+	}
+
+	if _, ok = MemoryRegionFor("VRAM", 0, true, true); ok {
+		t.Errorf("MemoryRegionFor(VRAM) ok, want unknown")
+	}
+}
@@ -0,0 +1,21 @@
+package mapping
+
+import "github.com/alttpo/snes"
+
+// Sizes returns the sizes of ROM ($FFD7) and SRAM ($FFD8, or else the expansion RAM size at $FFBD) a ROM header
+// declares as 1KB << n. romSize is 0 if the header declares an implausible size and sramSize is 0 if the ROM has no
+// SRAM.
+func Sizes(header *snes.Header) (romSize uint32, sramSize uint32) {
+	if header.ROMSize < 0x10 {
+		romSize = uint32(1024) << header.ROMSize
+	}
+
+	sramCode := header.RAMSize
+	if sramCode == 0 {
+		sramCode = header.ExpansionRAMSize
+	}
+	if sramCode != 0 && sramCode < 0x10 {
+		sramSize = uint32(1024) << sramCode
+	}
+	return
+}
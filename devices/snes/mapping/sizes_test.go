@@ -0,0 +1,29 @@
+package mapping
+
+import (
+	"github.com/alttpo/snes"
+	"testing"
+)
+
+func TestSizes(t *testing.T) {
+	tests := []struct {
+		header       snes.Header
+		wantROMSize  uint32
+		wantSRAMSize uint32
+	}{
+		{header: snes.Header{ROMSize: 0x09}, wantROMSize: 0x80000},
+		{header: snes.Header{ROMSize: 0x0C, RAMSize: 0x03}, wantROMSize: 0x400000, wantSRAMSize: 0x2000},
+		{header: snes.Header{ROMSize: 0x0A, ExpansionRAMSize: 0x05}, wantROMSize: 0x100000, wantSRAMSize: 0x8000},
+		{header: snes.Header{ROMSize: 0xFF, RAMSize: 0xFF}},
+	}
+	for _, tt := range tests {
+		romSize, sramSize := Sizes(&tt.header)
+		if romSize != tt.wantROMSize || sramSize != tt.wantSRAMSize {
+			t.Errorf(
+				"Sizes($%02x, $%02x, $%02x) = ($%x, $%x), want ($%x, $%x)",
+				tt.header.ROMSize, tt.header.RAMSize, tt.header.ExpansionRAMSize,
+				romSize, sramSize, tt.wantROMSize, tt.wantSRAMSize,
+			)
+		}
+	}
+}
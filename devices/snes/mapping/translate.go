@@ -11,6 +11,7 @@ import (
 )
 
 var ErrUnknownMapping = fmt.Errorf("cannot remap an address using an Unknown memory mapping; call MappingDetect to detect it from the ROM")
+var ErrUntranslatableSpace = fmt.Errorf("cannot translate an address out of the FX Pak Pro's MSU or CONFIG spaces")
 
 func TranslateAddress(
 	sourceAddress devices.AddressTuple,
@@ -20,6 +21,11 @@ func TranslateAddress(
 	switch sourceAddress.AddressSpace {
 	case sni.AddressSpace_Raw:
 		return address, nil
+	case sni.AddressSpace_FxPakProMSU, sni.AddressSpace_FxPakProConfig:
+		if deviceSpace == sourceAddress.AddressSpace || deviceSpace == sni.AddressSpace_Raw {
+			return address, nil
+		}
+		return 0, ErrUntranslatableSpace
 	case sni.AddressSpace_FxPakPro:
 		switch deviceSpace {
 		case sni.AddressSpace_Raw:
@@ -0,0 +1,30 @@
+package mapping
+
+import (
+	"sni/devices"
+	"sni/protos/sni"
+	"testing"
+)
+
+func TestTranslateAddress_PakOnlySpaces(t *testing.T) {
+	for _, space := range []sni.AddressSpace{sni.AddressSpace_FxPakProMSU, sni.AddressSpace_FxPakProConfig} {
+		source := devices.AddressTuple{Address: 0xF50010, AddressSpace: space, MemoryMapping: sni.MemoryMapping_LoROM}
+
+		for _, deviceSpace := range []sni.AddressSpace{space, sni.AddressSpace_Raw} {
+			address, err := TranslateAddress(source, deviceSpace)
+			if err != nil || address != source.Address {
+				t.Errorf("TranslateAddress(%s, %s) = ($%06x, %v), want ($%06x, nil)", space, deviceSpace, address, err, source.Address)
+			}
+		}
+
+		for _, deviceSpace := range []sni.AddressSpace{sni.AddressSpace_FxPakPro, sni.AddressSpace_SnesABus} {
+			if _, err := TranslateAddress(source, deviceSpace); err != ErrUntranslatableSpace {
+				t.Errorf("TranslateAddress(%s, %s) error = %v, want %v", space, deviceSpace, err, ErrUntranslatableSpace)
+			}
+		}
+
+		if memoryType, _, _ := MemoryTypeFor(source); memoryType != MemoryTypeUnknown {
+			t.Errorf("MemoryTypeFor(%s) = %s, want %s", space, memoryType, MemoryTypeUnknown)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package devices
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sni/protos/sni"
+	"strings"
+)
+
+// TreeEntry is a single file or directory visited by a recursive filesystem operation.
+type TreeEntry struct {
+	// Path is the full path of the entry on the device
+	Path string
+	Type sni.DirEntryType
+	// Size is the size of a file in bytes if known
+	Size uint32
+	// Err is the error that occurred processing this entry, if any
+	Err error
+}
+
+// TreeProgressFunc is called after every entry a recursive filesystem operation processed with the number of entries
+// done so far and the total number of entries, or 0 if the total is not known up front.
+type TreeProgressFunc func(entry TreeEntry, done int, total int)
+
+// Errors reading, writing or removing individual entries are reported per entry to the progress func and do not stop
+// the operation unless they are fatal to the device or ctx is canceled.
+func treeError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if IsFatal(err) {
+		return err
+	}
+	return nil
+}
+
+func joinDevicePath(dir, name string) string {
+	if strings.HasSuffix(dir, "/") {
+		return dir + name
+	}
+	return dir + "/" + name
+}
+
+// WalkDirectory lists root and all entries below it depth-first with every directory preceding its contents.
+// Subdirectories that cannot be read are listed with Err set and are not descended into.
+func WalkDirectory(ctx context.Context, fs DeviceFilesystem, root string) (entries []TreeEntry, err error) {
+	entries = make([]TreeEntry, 0, 64)
+	entries = append(entries, TreeEntry{Path: root, Type: sni.DirEntryType_Directory})
+
+	var files []DirEntry
+	files, err = fs.ReadDirectory(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	var walk func(dir string, files []DirEntry) error
+	walk = func(dir string, files []DirEntry) error {
+		for _, file := range files {
+			if file.Name == "." || file.Name == ".." {
+				continue
+			}
+
+			entry := TreeEntry{Path: joinDevicePath(dir, file.Name), Type: file.Type}
+			if file.Type != sni.DirEntryType_Directory {
+				entries = append(entries, entry)
+				continue
+			}
+
+			var children []DirEntry
+			children, entry.Err = fs.ReadDirectory(ctx, entry.Path)
+			entries = append(entries, entry)
+			if entry.Err != nil {
+				if err := treeError(ctx, entry.Err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := walk(entry.Path, children); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err = walk(root, files)
+	return
+}
+
+// RemoveTree removes root and all entries below it, deepest entries first. A directory is only removed if everything
+// below it was removed.
+func RemoveTree(ctx context.Context, fs DeviceFilesystem, root string, progress TreeProgressFunc) (err error) {
+	var entries []TreeEntry
+	entries, err = WalkDirectory(ctx, fs, root)
+	if err != nil {
+		return
+	}
+
+	// directories with entries below them that could not be removed:
+	kept := make(map[string]struct{})
+	keepParents := func(p string) {
+		for p != root && p != "/" && p != "." && p != "" {
+			p = path.Dir(p)
+			kept[p] = struct{}{}
+		}
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Err != nil {
+			keepParents(entry.Path)
+		} else if _, ok := kept[entry.Path]; ok {
+			entry.Err = fmt.Errorf("directory '%s' is not empty", entry.Path)
+			keepParents(entry.Path)
+		} else if entry.Err = fs.RemoveFile(ctx, entry.Path); entry.Err != nil {
+			keepParents(entry.Path)
+			if err = treeError(ctx, entry.Err); err != nil {
+				return
+			}
+		}
+
+		if progress != nil {
+			progress(entry, len(entries)-i, len(entries))
+		}
+	}
+
+	return
+}
+
+// mkdirAll creates dir and all its missing parents, remembering which directories exist in made.
+func mkdirAll(ctx context.Context, fs DeviceFilesystem, dir string, made map[string]struct{}) (err error) {
+	if dir == "" || dir == "/" || dir == "." {
+		return
+	}
+	if _, ok := made[dir]; ok {
+		return
+	}
+
+	err = mkdirAll(ctx, fs, path.Dir(dir), made)
+	if err != nil {
+		return
+	}
+
+	err = fs.MakeDirectory(ctx, dir)
+	if err != nil {
+		// the directory may already exist:
+		if _, rerr := fs.ReadDirectory(ctx, dir); rerr != nil {
+			return
+		}
+		err = nil
+	}
+
+	made[dir] = struct{}{}
+	return
+}
+
+// PutTree extracts the tar archive read from r below root on the device, creating directories as needed. Only regular
+// files and directories are supported and archive paths may not point outside of root.
+func PutTree(ctx context.Context, fs DeviceFilesystem, root string, r io.Reader, progress TreeProgressFunc) (err error) {
+	made := make(map[string]struct{})
+	root = path.Clean("/" + root)
+	err = mkdirAll(ctx, fs, root, made)
+	if err != nil {
+		return
+	}
+
+	tr := tar.NewReader(r)
+	for done := 1; ; done++ {
+		var hdr *tar.Header
+		hdr, err = tr.Next()
+		if err == io.EOF {
+			err = nil
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf("could not read archive: %w", err)
+			return
+		}
+
+		name := path.Clean("/" + hdr.Name)
+		entry := TreeEntry{Path: path.Join(root, name), Type: sni.DirEntryType_File}
+		if rel := path.Clean(hdr.Name); rel == ".." || strings.HasPrefix(rel, "../") {
+			entry.Err = fmt.Errorf("archive entry '%s' points outside of '%s'", hdr.Name, root)
+		} else {
+			switch hdr.Typeflag {
+			case tar.TypeDir:
+				entry.Type = sni.DirEntryType_Directory
+				entry.Err = mkdirAll(ctx, fs, entry.Path, made)
+			case tar.TypeReg:
+				entry.Size = uint32(hdr.Size)
+				entry.Err = mkdirAll(ctx, fs, path.Dir(entry.Path), made)
+				if entry.Err == nil {
+					_, entry.Err = fs.PutFile(ctx, entry.Path, entry.Size, tr, nil)
+				}
+			default:
+				entry.Err = fmt.Errorf("archive entry '%s' has unsupported type '%c'", hdr.Name, hdr.Typeflag)
+			}
+		}
+
+		if entry.Err != nil {
+			if err = treeError(ctx, entry.Err); err != nil {
+				return
+			}
+		}
+
+		if progress != nil {
+			progress(entry, done, 0)
+		}
+	}
+}
+
+// GetTree writes root and all entries below it to w as a tar archive with paths relative to root. Files that cannot
+// be read are left out of the archive.
+func GetTree(ctx context.Context, fs DeviceFilesystem, root string, w io.Writer, progress TreeProgressFunc) (err error) {
+	var entries []TreeEntry
+	entries, err = WalkDirectory(ctx, fs, root)
+	if err != nil {
+		return
+	}
+
+	tw := tar.NewWriter(w)
+	b := &bytes.Buffer{}
+	for i, entry := range entries {
+		name := strings.TrimPrefix(strings.TrimPrefix(entry.Path, root), "/")
+
+		if entry.Type == sni.DirEntryType_Directory {
+			if name != "" {
+				err = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeDir, Name: name + "/", Mode: 0755})
+				if err != nil {
+					return
+				}
+			}
+		} else {
+			// read the whole file first so that a failure does not leave a truncated entry in the archive:
+			b.Reset()
+			entry.Size, entry.Err = fs.GetFile(ctx, entry.Path, b, nil, nil)
+			if entry.Err != nil {
+				if err = treeError(ctx, entry.Err); err != nil {
+					return
+				}
+			} else {
+				err = tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Mode: 0644, Size: int64(b.Len())})
+				if err != nil {
+					return
+				}
+				_, err = tw.Write(b.Bytes())
+				if err != nil {
+					return
+				}
+			}
+		}
+
+		if progress != nil {
+			progress(entry, i+1, len(entries))
+		}
+	}
+
+	err = tw.Close()
+	return
+}
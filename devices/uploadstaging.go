@@ -0,0 +1,212 @@
+package devices
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sni/util"
+	"strconv"
+	"time"
+)
+
+// Resumable uploads are staged on the host, keyed by device URI, file name and total size, until all of their data
+// has arrived. An interrupted transfer resumes by staging the remaining data from the offset returned by
+// StagedUploadSize and only complete uploads are sent to the device. A different total size starts a new upload so
+// that a changed file is never completed from stale data.
+
+// stagedUploadMaxAge is how long an abandoned staged upload is kept before it is pruned:
+const stagedUploadMaxAge = 7 * 24 * time.Hour
+
+func stagedUploadPath(uri *url.URL, filename string, totalSize uint32) (p string, err error) {
+	var dir string
+	dir, err = util.ConfigDir()
+	if err != nil {
+		return
+	}
+
+	key := sha256.Sum256([]byte(uri.String() + "\x00" + filename + "\x00" + strconv.FormatUint(uint64(totalSize), 10)))
+	p = filepath.Join(dir, "uploads", hex.EncodeToString(key[:16]))
+	return
+}
+
+// pruneStagedUploads removes staged uploads in dir that have not been written to for longer than maxAge.
+func pruneStagedUploads(dir string, maxAge time.Duration) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		fi, err := entry.Info()
+		if err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		if time.Since(fi.ModTime()) <= maxAge {
+			continue
+		}
+		_ = os.Remove(filepath.Join(dir, entry.Name()))
+	}
+}
+
+// StagedUploadSize returns the number of bytes staged for filename of totalSize bytes on the device at uri.
+func StagedUploadSize(uri *url.URL, filename string, totalSize uint32) (staged uint32, err error) {
+	var p string
+	p, err = stagedUploadPath(uri, filename, totalSize)
+	if err != nil {
+		return
+	}
+
+	var fi os.FileInfo
+	fi, err = os.Stat(p)
+	if os.IsNotExist(err) {
+		err = nil
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	staged = uint32(fi.Size())
+	return
+}
+
+// StageUpload writes up to size bytes read from r at offset into the staged upload for filename of totalSize bytes on
+// the device at uri and returns the number of bytes staged so far, which includes any data written before an error
+// occurred. offset may not be past the end of the staged data; staged data after offset is discarded. Staged uploads
+// abandoned for longer than stagedUploadMaxAge are pruned along the way.
+func StageUpload(uri *url.URL, filename string, totalSize uint32, offset uint32, r io.Reader, size uint32) (staged uint32, err error) {
+	var p string
+	p, err = stagedUploadPath(uri, filename, totalSize)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(filepath.Dir(p), 0755)
+	if err != nil {
+		return
+	}
+	pruneStagedUploads(filepath.Dir(p), stagedUploadMaxAge)
+
+	var f *os.File
+	f, err = os.OpenFile(p, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+
+	var fi os.FileInfo
+	fi, err = f.Stat()
+	if err != nil {
+		return
+	}
+	if uint64(offset)+uint64(size) > uint64(totalSize) {
+		staged = uint32(fi.Size())
+		err = WithCode(
+			codes.InvalidArgument,
+			fmt.Errorf("upload of %d bytes at offset %d exceeds totalSize %d", size, offset, totalSize),
+		)
+		return
+	}
+	if int64(offset) > fi.Size() {
+		staged = uint32(fi.Size())
+		err = WithCode(
+			codes.FailedPrecondition,
+			fmt.Errorf("upload offset %d is past the %d bytes already staged", offset, staged),
+		)
+		return
+	}
+
+	err = f.Truncate(int64(offset))
+	if err != nil {
+		return
+	}
+	_, err = f.Seek(int64(offset), io.SeekStart)
+	if err != nil {
+		return
+	}
+
+	var n int64
+	n, err = io.Copy(f, io.LimitReader(r, int64(size)))
+	staged = offset + uint32(n)
+	return
+}
+
+// OpenStagedUpload opens the staged upload for filename of totalSize bytes on the device at uri for reading.
+func OpenStagedUpload(uri *url.URL, filename string, totalSize uint32) (f *os.File, size uint32, err error) {
+	var p string
+	p, err = stagedUploadPath(uri, filename, totalSize)
+	if err != nil {
+		return
+	}
+
+	f, err = os.Open(p)
+	if err != nil {
+		return
+	}
+
+	var fi os.FileInfo
+	fi, err = f.Stat()
+	if err != nil {
+		_ = f.Close()
+		f = nil
+		return
+	}
+
+	size = uint32(fi.Size())
+	return
+}
+
+// DiscardStagedUpload removes the staged upload for filename of totalSize bytes on the device at uri, if any.
+func DiscardStagedUpload(uri *url.URL, filename string, totalSize uint32) (err error) {
+	var p string
+	p, err = stagedUploadPath(uri, filename, totalSize)
+	if err != nil {
+		return
+	}
+
+	err = os.Remove(p)
+	if os.IsNotExist(err) {
+		err = nil
+	}
+	return
+}
+
+// PutStagedUpload uploads the staged upload for filename of totalSize bytes on the device at uri with
+// PutFileWithOptions and discards it once the upload succeeded.
+func PutStagedUpload(
+	ctx context.Context,
+	fs DeviceFilesystem,
+	uri *url.URL,
+	filename string,
+	totalSize uint32,
+	options PutFileOptions,
+	progress ProgressReportFunc,
+) (n uint32, err error) {
+	var f *os.File
+	var size uint32
+	f, size, err = OpenStagedUpload(uri, filename, totalSize)
+	if err != nil {
+		return
+	}
+
+	if size != totalSize {
+		_ = f.Close()
+		err = WithCode(codes.FailedPrecondition, fmt.Errorf("staged upload has %d of %d bytes", size, totalSize))
+		return
+	}
+
+	n, err = PutFileWithOptions(ctx, fs, filename, size, f, options, progress)
+	_ = f.Close()
+	if err != nil {
+		return
+	}
+
+	err = DiscardStagedUpload(uri, filename, totalSize)
+	return
+}
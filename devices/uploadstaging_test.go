@@ -0,0 +1,54 @@
+package devices
+
+import (
+	"bytes"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestStageUpload(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	uri := &url.URL{Scheme: "test", Host: ".", Path: "/" + t.Name()}
+
+	// an abandoned upload of some other file:
+	abandoned, err := stagedUploadPath(uri, "/old.sfc", 0x100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(filepath.Dir(abandoned), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(abandoned, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := time.Now().Add(-stagedUploadMaxAge - time.Hour)
+	if err = os.Chtimes(abandoned, old, old); err != nil {
+		t.Fatal(err)
+	}
+
+	staged, err := StageUpload(uri, "/test.sfc", 8, 0, bytes.NewReader([]byte("0123")), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if staged != 4 {
+		t.Fatalf("StageUpload() = %d, want 4", staged)
+	}
+	if _, err = os.Stat(abandoned); !os.IsNotExist(err) {
+		t.Fatalf("StageUpload() did not prune the abandoned upload: %v", err)
+	}
+
+	// the same file with a different total size is a different upload:
+	if staged, _ = StagedUploadSize(uri, "/test.sfc", 16); staged != 0 {
+		t.Fatalf("StagedUploadSize() = %d for a different totalSize, want 0", staged)
+	}
+	if staged, _ = StagedUploadSize(uri, "/test.sfc", 8); staged != 4 {
+		t.Fatalf("StagedUploadSize() = %d, want 4", staged)
+	}
+
+	if _, err = StageUpload(uri, "/test.sfc", 8, 4, bytes.NewReader([]byte("456789")), 6); err == nil {
+		t.Fatal("expected error staging past totalSize")
+	}
+}
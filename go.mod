@@ -11,6 +11,7 @@ require (
 	github.com/improbable-eng/grpc-web v0.15.0
 	github.com/json-iterator/go v1.1.12
 	github.com/postfinance/single v0.0.2
+	github.com/spf13/cast v1.5.0
 	github.com/spf13/viper v1.15.0
 	go.bug.st/serial v1.5.0
 	google.golang.org/grpc v1.52.3
@@ -45,7 +46,6 @@ require (
 	github.com/pelletier/go-toml/v2 v2.0.6 // indirect
 	github.com/rs/cors v1.8.3 // indirect
 	github.com/spf13/afero v1.9.3 // indirect
-	github.com/spf13/cast v1.5.0 // indirect
 	github.com/spf13/jwalterweatherman v1.1.0 // indirect
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/subosito/gotenv v1.4.2 // indirect
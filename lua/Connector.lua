@@ -1,251 +1,431 @@
--- original file found in a GPLv3 code repository, unclear if this is the intended license nor who the authors are
--- SNI modifications by Berserker, jsd1982; modifications licensed under MIT License
--- version 3 changes Read response from JSON to HEX
--- lua 5.1/5.4 shim by zig; modifications licensed under MIT and WTFPL
-
-function get_lua_version()
-    local major, minor = _VERSION:match("Lua (%d+)%.(%d+)")
-    assert(tonumber(major) == 5)
-    if tonumber(minor) >= 4 then
-        return "5-4"
-    end
-    return "5-1"
-end
-
-if not event then
-    is_snes9x = true
-    memory.usememorydomain = function()
-        -- snes9x always uses "System Bus" domain, which cannot be switched
-    end
-else
-    if emu.getsystemid() ~= "SNES" then
-        print("Connector only for BSNES Core within Bizhawk, sorry.")
-    end
-    local current_engine = nil;
-    -- client.get_lua_engine is new
-    if client.get_lua_engine ~= nil then
-        current_engine = client.get_lua_engine();
-    -- emu.getluacore for old BizHawk
-    elseif emu.getluacore ~= nil then
-        current_engine = emu.getluacore();
-    end
-    if current_engine ~= nil and current_engine ~= "LuaInterface" and get_lua_version() ~= "5-4" then
-        print("Wrong Lua Core. Found " .. current_engine .. ", was expecting LuaInterface. ")
-        print("Please go to Config -> Customize -> Advanced and select Lua+LuaInterface.")
-        print("Once set, restart Bizhawk.")
-    end
-end
-
-function readbyterange(addr, length, domain)
-    local mtable;
-    local mstart = 0;
-    local mend = length - 1;
-    if is_snes9x then
-        mtable = memory.readbyterange(addr, length);
-        mstart = 1
-        mend = length
-    else
-        -- jsd: wrap around address by domain size:
-        local domainsize = memory.getmemorydomainsize(domain)
-        while addr >= domainsize do
-            addr = addr - domainsize
-        end
-        mtable = memory.readbyterange(addr, length, domain)
-        mstart = 0;
-        mend = length - 1;
-    end
-
-    -- jsd: format output in 2-char hex per byte:
-    local toret = {};
-    for i=mstart, mend do
-        table.insert(toret, string.format("%02x", mtable[i]))
-    end
-    return toret
-end
-function writebyte(addr, value, domain)
-  if is_snes9x then
-    memory.writebyte(addr, value)
-  else
-    -- jsd: wrap around address by domain size:
-    local domainsize = memory.getmemorydomainsize(domain)
-    while addr >= domainsize do
-        addr = addr - domainsize
-    end
-    memory.writebyte(addr, value, domain)
-  end
-end
-
-function get_os()
-    local the_os, ext, arch
-    if package.config:sub(1,1) == "\\" then
-        the_os, ext = "windows", "dll"
-        arch = os.getenv"PROCESSOR_ARCHITECTURE"
-    else
-        -- TODO: macos?
-        the_os, ext = "linux", "so"
-        arch = "x86_64" -- TODO: read ELF header from /proc/$PID/exe to get arch
-    end
-
-    if arch:find("64") ~= nil then
-        arch = "x64"
-    else
-        arch = "x86"
-    end
-
-    return the_os, ext, arch
-end
-
-function get_socket_path()
-    local the_os, ext, arch = get_os()
-    -- for some reason ./ isn't working, so use a horrible hack to get the pwd
-    local pwd = (io.popen and io.popen("cd"):read'*l') or "."
-	return pwd .. "/" .. arch .. "/socket-" .. the_os .. "-" .. get_lua_version() .. "." .. ext
-end
-local socket_path = get_socket_path()
-print("loading " .. socket_path)
-local socket = assert(package.loadlib(socket_path, "luaopen_socket_core"))()
-
-local connection
-local host = os.getenv("SNI_LUABRIDGE_LISTEN_HOST") or '127.0.0.1'
-local port = os.getenv("SNI_LUABRIDGE_LISTEN_PORT") or 65398
-local connected = false
-local name = "Unnamed"
-
-memory.usememorydomain("System Bus")
-
-local function onMessage(s)
-    local parts = {}
-    for part in string.gmatch(s, '([^|]+)') do
-        parts[#parts + 1] = part
-    end
-    if parts[1] == "Read" then
-        local adr = tonumber(parts[2])
-        local length = tonumber(parts[3])
-        local domain
-        if is_snes9x ~= true then
-          domain = parts[4]
-        end
-        local byteRange = readbyterange(adr, length, domain)
-        connection:send(table.concat(byteRange) .. "\n")
-    elseif parts[1] == "Write" then
-        local adr = tonumber(parts[2])
-        local domain
-        local offset = 2
-        if is_snes9x ~= true then
-          domain = parts[3]
-          offset = 3
-        end
-        for k, v in pairs(parts) do
-            if k > offset then
-                writebyte(adr + k - offset - 1, tonumber(v), domain)
-            end
-        end
-    elseif parts[1] == "SetName" then
-        name = parts[2]
-        print("My name is " .. name .. "!")
-    elseif parts[1] == "Message" then
-        print(parts[2])
-    elseif parts[1] == "Version" then
-        if is_snes9x then
-            connection:send("Version|SNI Connector|3|Snes9x\n")
-        else
-            connection:send("Version|SNI Connector|3|Bizhawk\n")
-        end
-    elseif is_snes9x ~= true then
-        if parts[1] == "Reset" then
-            print("Rebooting core...")
-            client.reboot_core()
-        elseif parts[1] == "Pause" then
-            print("Pausing...")
-            client.pause()
-        elseif parts[1] == "Unpause" then
-            print("Unpausing...")
-            client.unpause()
-        elseif parts[1] == "PauseToggle" then
-            print("Toggling pause...")
-            client.togglepause()
-        end
-    end
-end
-
-local connectionBackOff = 0
-local localIP, localPort, localFam
-
-local function doclose()
-    if connection ~= nil then
-        print('Closing connection to SNI from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
-        connection:close()
-        print('Connection to SNI is closed from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
-        localIP, localPort, localFam = nil, nil, nil
-        connection = nil
-    end
-    connected = false
-end
-
-local function main()
-    if not connected then
-        if connectionBackOff > 0 then
-            connectionBackOff = connectionBackOff - 1
-            return
-        end
-        connectionBackOff = 60 * 10
-
-        local err
-        print('Connecting to SNI at ' .. host .. ':' .. port .. ' ...')
-        connection, err = socket:tcp()
-        if err ~= nil then
-            print(err)
-            print('Waiting 10 seconds...')
-            return
-        end
-
-        connection:setoption('keepalive', true)
-        connection:setoption('tcp-nodelay', true)
-        connection:settimeout(0.01)
-        local returnCode, err = connection:connect(host, port)
-        if err ~= nil then
-            print("Error while connecting: " .. err)
-            print('Waiting 10 seconds...')
-            connected = false
-            return
-        end
-
-        connected = true
-
-        localIP, localPort, localFam = connection:getsockname()
-        if localFam == nil then
-            localFam = 'inet'
-        end
-        print('Connected to SNI from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
-        connection:settimeout(0)
-        return
-    end
-
-    local s, status = connection:receive('*l')
-    if s then
-        onMessage(s)
-    end
-    if status == 'closed' then
-        print('SNI closed the connection')
-        doclose()
-        print('Waiting 10 seconds...')
-        return
-    end
-end
-
-local function onexit()
-    doclose()
-end
-
-if is_snes9x then
-    -- snes9x-rr:
-    emu.registerexit(onexit)
-    emu.registerbefore(main)
-else
-    -- bizhawk:
-    event.onexit(onexit)
-    while true do
-        main()
-        emu.frameadvance()
-    end
-end
+-- original file found in a GPLv3 code repository, unclear if this is the intended license nor who the authors are
+-- SNI modifications by Berserker, jsd1982; modifications licensed under MIT License
+-- version 3 changes Read response from JSON to HEX
+-- version 4 adds batched MultiRead and MultiWrite commands in binary frames
+-- lua 5.1/5.4 shim by zig; modifications licensed under MIT and WTFPL
+
+function get_lua_version()
+    local major, minor = _VERSION:match("Lua (%d+)%.(%d+)")
+    assert(tonumber(major) == 5)
+    if tonumber(minor) >= 4 then
+        return "5-4"
+    end
+    return "5-1"
+end
+
+if not event then
+    is_snes9x = true
+    memory.usememorydomain = function()
+        -- snes9x always uses "System Bus" domain, which cannot be switched
+    end
+else
+    if emu.getsystemid() ~= "SNES" then
+        print("Connector only for BSNES Core within Bizhawk, sorry.")
+    end
+    local current_engine = nil;
+    -- client.get_lua_engine is new
+    if client.get_lua_engine ~= nil then
+        current_engine = client.get_lua_engine();
+    -- emu.getluacore for old BizHawk
+    elseif emu.getluacore ~= nil then
+        current_engine = emu.getluacore();
+    end
+    if current_engine ~= nil and current_engine ~= "LuaInterface" and get_lua_version() ~= "5-4" then
+        print("Wrong Lua Core. Found " .. current_engine .. ", was expecting LuaInterface. ")
+        print("Please go to Config -> Customize -> Advanced and select Lua+LuaInterface.")
+        print("Once set, restart Bizhawk.")
+    end
+end
+
+-- returns a 1-based table of the byte values read:
+function readbytes(addr, length, domain)
+    local mtable;
+    local mstart = 0;
+    if is_snes9x then
+        mtable = memory.readbyterange(addr, length);
+        mstart = 1
+    else
+        -- jsd: wrap around address by domain size:
+        local domainsize = memory.getmemorydomainsize(domain)
+        while addr >= domainsize do
+            addr = addr - domainsize
+        end
+        mtable = memory.readbyterange(addr, length, domain)
+        mstart = 0;
+    end
+
+    local bytes = {};
+    for i=0, length - 1 do
+        bytes[i + 1] = mtable[mstart + i]
+    end
+    return bytes
+end
+function readbyterange(addr, length, domain)
+    -- jsd: format output in 2-char hex per byte:
+    local toret = {};
+    for i, v in ipairs(readbytes(addr, length, domain)) do
+        toret[i] = string.format("%02x", v)
+    end
+    return toret
+end
+function writebyte(addr, value, domain)
+  if is_snes9x then
+    memory.writebyte(addr, value)
+  else
+    -- jsd: wrap around address by domain size:
+    local domainsize = memory.getmemorydomainsize(domain)
+    while addr >= domainsize do
+        addr = addr - domainsize
+    end
+    memory.writebyte(addr, value, domain)
+  end
+end
+
+function get_os()
+    local the_os, ext, arch
+    if package.config:sub(1,1) == "\\" then
+        the_os, ext = "windows", "dll"
+        arch = os.getenv"PROCESSOR_ARCHITECTURE"
+    else
+        -- TODO: macos?
+        the_os, ext = "linux", "so"
+        arch = "x86_64" -- TODO: read ELF header from /proc/$PID/exe to get arch
+    end
+
+    if arch:find("64") ~= nil then
+        arch = "x64"
+    else
+        arch = "x86"
+    end
+
+    return the_os, ext, arch
+end
+
+function get_socket_path()
+    local the_os, ext, arch = get_os()
+    -- for some reason ./ isn't working, so use a horrible hack to get the pwd
+    local pwd = (io.popen and io.popen("cd"):read'*l') or "."
+	return pwd .. "/" .. arch .. "/socket-" .. the_os .. "-" .. get_lua_version() .. "." .. ext
+end
+local socket_path = get_socket_path()
+print("loading " .. socket_path)
+local socket = assert(package.loadlib(socket_path, "luaopen_socket_core"))()
+
+local connection
+local host = os.getenv("SNI_LUABRIDGE_LISTEN_HOST") or '127.0.0.1'
+local port = os.getenv("SNI_LUABRIDGE_LISTEN_PORT") or 65398
+local connected = false
+local name = "Unnamed"
+-- frames left to run before pausing again after a FrameAdvance command:
+local advance_frames = 0
+
+memory.usememorydomain("System Bus")
+
+-- state|rom name; state is one of running, paused, nocontent or unknown:
+local function get_emulation_state()
+    if is_snes9x then
+        if emu.paused == nil then
+            return "unknown|"
+        elseif emu.paused() then
+            return "paused|"
+        end
+        return "running|"
+    end
+
+    local rom = gameinfo.getromname()
+    if rom == nil or rom == "" or rom == "Null" then
+        return "nocontent|"
+    elseif client.ispaused() and advance_frames == 0 then
+        return "paused|" .. rom
+    end
+    return "running|" .. rom
+end
+
+local unpack = table.unpack or unpack
+
+-- big-endian u32 at position i of a binary frame payload:
+local function decode_u32(payload, i)
+    local b1, b2, b3, b4 = string.byte(payload, i, i + 3)
+    return ((b1 * 256 + b2) * 256 + b3) * 256 + b4
+end
+
+-- decodes the range at position i of a MultiRead/MultiWrite payload; returns the position after it:
+local function decode_range(payload, i)
+    local n = string.byte(payload, i)
+    local domain = string.sub(payload, i + 1, i + n)
+    i = i + 1 + n
+    if is_snes9x then
+        domain = nil
+    end
+    return domain, decode_u32(payload, i), decode_u32(payload, i + 4), i + 8
+end
+
+-- converts a table of byte values to a binary string; string.char is limited in its number of arguments:
+local function bytes_to_string(bytes)
+    local chunks = {}
+    for i = 1, #bytes, 4096 do
+        chunks[#chunks + 1] = string.char(unpack(bytes, i, math.min(i + 4095, #bytes)))
+    end
+    return table.concat(chunks)
+end
+
+-- reads the binary payload that immediately follows a MultiRead/MultiWrite command line:
+local function receive_payload(length)
+    connection:settimeout(5)
+    local payload, err = connection:receive(length)
+    connection:settimeout(0)
+    if payload == nil then
+        print("Error while receiving payload: " .. err)
+    end
+    return payload
+end
+
+-- core|rom hash; Snes9x cannot report the ROM it runs:
+local function get_game_info()
+    if is_snes9x then
+        return "Snes9x|"
+    end
+
+    -- BizHawk only tells scripts which core runs the game through the header of a running movie; otherwise report the
+    -- system id since the preferred core setting is not necessarily the core that runs:
+    local core = emu.getsystemid() or ""
+    pcall(function()
+        if movie.isloaded() then
+            local header = movie.getheader()
+            if header ~= nil and header["Core"] ~= nil and header["Core"] ~= "" then
+                core = header["Core"]
+            end
+        end
+    end)
+
+    local hash = ""
+    local rom = gameinfo.getromname()
+    if rom ~= nil and rom ~= "" and rom ~= "Null" then
+        hash = gameinfo.getromhash() or ""
+    end
+    return core .. "|" .. hash
+end
+
+local function onMessage(s)
+    local parts = {}
+    for part in string.gmatch(s, '([^|]+)') do
+        parts[#parts + 1] = part
+    end
+    if parts[1] == "Read" then
+        local adr = tonumber(parts[2])
+        local length = tonumber(parts[3])
+        local domain
+        if is_snes9x ~= true then
+          domain = parts[4]
+        end
+        local byteRange = readbyterange(adr, length, domain)
+        connection:send(table.concat(byteRange) .. "\n")
+    elseif parts[1] == "Write" then
+        local adr = tonumber(parts[2])
+        local domain
+        local offset = 2
+        if is_snes9x ~= true then
+          domain = parts[3]
+          offset = 3
+        end
+        for k, v in pairs(parts) do
+            if k > offset then
+                writebyte(adr + k - offset - 1, tonumber(v), domain)
+            end
+        end
+    elseif parts[1] == "MultiRead" then
+        local payload = receive_payload(tonumber(parts[2]))
+        if payload == nil then
+            return
+        end
+        local data = {}
+        local i = 1
+        while i <= #payload do
+            local domain, adr, length
+            domain, adr, length, i = decode_range(payload, i)
+            data[#data + 1] = bytes_to_string(readbytes(adr, length, domain))
+        end
+        data = table.concat(data)
+        connection:send("MultiRead|" .. #data .. "\n" .. data)
+    elseif parts[1] == "MultiWrite" then
+        local payload = receive_payload(tonumber(parts[2]))
+        if payload == nil then
+            return
+        end
+        local i = 1
+        while i <= #payload do
+            local domain, adr, length
+            domain, adr, length, i = decode_range(payload, i)
+            for k = 0, length - 1 do
+                writebyte(adr + k, string.byte(payload, i + k), domain)
+            end
+            i = i + length
+        end
+    elseif parts[1] == "SetName" then
+        name = parts[2]
+        print("My name is " .. name .. "!")
+    elseif parts[1] == "Message" then
+        print(parts[2])
+    elseif parts[1] == "Version" then
+        -- the last field lists the optional commands this connector replies to:
+        if is_snes9x then
+            connection:send("Version|SNI Connector|4|Snes9x|EmulationState,GameInfo\n")
+        else
+            connection:send("Version|SNI Connector|4|Bizhawk|EmulationState,GameInfo,Screenshot,BootRom,MemoryDomains\n")
+        end
+    elseif parts[1] == "EmulationState" then
+        connection:send("EmulationState|" .. get_emulation_state() .. "\n")
+    elseif parts[1] == "GameInfo" then
+        connection:send("GameInfo|" .. get_game_info() .. "|" .. get_emulation_state() .. "\n")
+    elseif parts[1] == "SaveStateSlot" then
+        local slot = tonumber(parts[2])
+        print("Saving state to slot " .. slot .. "...")
+        if is_snes9x then
+            savestate.save(savestate.create(slot))
+        else
+            savestate.saveslot(slot)
+        end
+    elseif parts[1] == "LoadStateSlot" then
+        local slot = tonumber(parts[2])
+        print("Loading state from slot " .. slot .. "...")
+        if is_snes9x then
+            savestate.load(savestate.create(slot))
+        else
+            savestate.loadslot(slot)
+        end
+    elseif parts[1] == "SetSpeed" then
+        local percent = tonumber(parts[2])
+        if is_snes9x then
+            if percent > 100 then
+                emu.speedmode("turbo")
+            else
+                emu.speedmode("normal")
+            end
+        else
+            client.speedmode(percent)
+        end
+    elseif is_snes9x ~= true then
+        if parts[1] == "Reset" then
+            print("Rebooting core...")
+            client.reboot_core()
+        elseif parts[1] == "Pause" then
+            print("Pausing...")
+            client.pause()
+        elseif parts[1] == "Unpause" then
+            print("Unpausing...")
+            client.unpause()
+        elseif parts[1] == "PauseToggle" then
+            print("Toggling pause...")
+            client.togglepause()
+        elseif parts[1] == "SaveState" then
+            print("Saving state to " .. parts[2] .. "...")
+            savestate.save(parts[2])
+        elseif parts[1] == "LoadState" then
+            print("Loading state from " .. parts[2] .. "...")
+            savestate.load(parts[2])
+        elseif parts[1] == "FrameAdvance" then
+            advance_frames = tonumber(parts[2])
+            client.unpause()
+        elseif parts[1] == "Screenshot" then
+            client.screenshot(parts[2])
+            connection:send("Screenshot|" .. parts[2] .. "\n")
+        elseif parts[1] == "MemoryDomains" then
+            local domains = {}
+            for _, domain in pairs(memory.getmemorydomainlist()) do
+                domains[#domains + 1] = domain .. ":" .. memory.getmemorydomainsize(domain)
+            end
+            connection:send("MemoryDomains|" .. table.concat(domains, "|") .. "\n")
+        elseif parts[1] == "BootRom" then
+            print("Booting " .. parts[2] .. "...")
+            client.openrom(parts[2])
+        end
+    end
+end
+
+local connectionBackOff = 0
+local localIP, localPort, localFam
+
+local function doclose()
+    if connection ~= nil then
+        print('Closing connection to SNI from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
+        connection:close()
+        print('Connection to SNI is closed from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
+        localIP, localPort, localFam = nil, nil, nil
+        connection = nil
+    end
+    connected = false
+end
+
+local function main()
+    if not connected then
+        if connectionBackOff > 0 then
+            connectionBackOff = connectionBackOff - 1
+            return
+        end
+        connectionBackOff = 60 * 10
+
+        local err
+        print('Connecting to SNI at ' .. host .. ':' .. port .. ' ...')
+        connection, err = socket:tcp()
+        if err ~= nil then
+            print(err)
+            print('Waiting 10 seconds...')
+            return
+        end
+
+        connection:setoption('keepalive', true)
+        connection:setoption('tcp-nodelay', true)
+        connection:settimeout(0.01)
+        local returnCode, err = connection:connect(host, port)
+        if err ~= nil then
+            print("Error while connecting: " .. err)
+            print('Waiting 10 seconds...')
+            connected = false
+            return
+        end
+
+        connected = true
+
+        localIP, localPort, localFam = connection:getsockname()
+        if localFam == nil then
+            localFam = 'inet'
+        end
+        print('Connected to SNI from ' .. localIP .. ':' .. localPort .. ' (' .. localFam .. ')')
+        connection:settimeout(0)
+        return
+    end
+
+    local s, status = connection:receive('*l')
+    if s then
+        onMessage(s)
+    end
+    if status == 'closed' then
+        print('SNI closed the connection')
+        doclose()
+        print('Waiting 10 seconds...')
+        return
+    end
+end
+
+local function onexit()
+    doclose()
+end
+
+if is_snes9x then
+    -- snes9x-rr:
+    emu.registerexit(onexit)
+    emu.registerbefore(main)
+else
+    -- bizhawk:
+    event.onexit(onexit)
+    while true do
+        main()
+        emu.frameadvance()
+        if advance_frames > 0 then
+            advance_frames = advance_frames - 1
+            if advance_frames == 0 then
+                client.pause()
+            end
+        end
+    end
+end
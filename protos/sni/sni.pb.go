@@ -35,12 +35,18 @@ const (
 	// $F9_0420..$F9_04FF =  MISC contents, linearly mapped
 	// $F9_0500..$F9_06FF =         PPUREG, linearly mapped
 	// $F9_0700..$F9_08FF =         CPUREG, linearly mapped
+	// The top byte of the 32-bit address selects the FX Pak Pro's space:
+	// $00_xxxxxx = SNES, $01_xxxxxx = CMD
 	// translated device address depends on device being talked to and its current MemoryMapping mode
 	AddressSpace_FxPakPro AddressSpace = 0 // SNES
 	// The SNES's main A-bus; address depends on device's current MemoryMapping mode, e.g. LoROM, HiROM, ExHiROM, etc.
 	AddressSpace_SnesABus AddressSpace = 1
 	// Do not do any address translation; simply pass the raw address to the device as-is:
 	AddressSpace_Raw AddressSpace = 2
+	// The FX Pak Pro's MSU-1 data space, $00_0000..$FF_FFFF; only available on FX Pak Pro devices:
+	AddressSpace_FxPakProMSU AddressSpace = 3
+	// The FX Pak Pro's firmware configuration space, $00_0000..$FF_FFFF; only available on FX Pak Pro devices:
+	AddressSpace_FxPakProConfig AddressSpace = 4
 )
 
 // Enum value maps for AddressSpace.
@@ -49,11 +55,15 @@ var (
 		0: "FxPakPro",
 		1: "SnesABus",
 		2: "Raw",
+		3: "FxPakProMSU",
+		4: "FxPakProConfig",
 	}
 	AddressSpace_value = map[string]int32{
-		"FxPakPro": 0,
-		"SnesABus": 1,
-		"Raw":      2,
+		"FxPakPro":       0,
+		"SnesABus":       1,
+		"Raw":            2,
+		"FxPakProMSU":    3,
+		"FxPakProConfig": 4,
 	}
 )
 
@@ -161,6 +171,13 @@ const (
 	DeviceCapability_GetFile               DeviceCapability = 15
 	DeviceCapability_BootFile              DeviceCapability = 16
 	DeviceCapability_NWACommand            DeviceCapability = 20
+	DeviceCapability_SaveState             DeviceCapability = 30
+	DeviceCapability_Screenshot            DeviceCapability = 31
+	DeviceCapability_FrameAdvance          DeviceCapability = 32
+	DeviceCapability_SetEmulationSpeed     DeviceCapability = 33
+	DeviceCapability_GetEmulationState     DeviceCapability = 34
+	DeviceCapability_BootRom               DeviceCapability = 35
+	DeviceCapability_ListMemoryRegions     DeviceCapability = 36
 )
 
 // Enum value maps for DeviceCapability.
@@ -183,6 +200,13 @@ var (
 		15: "GetFile",
 		16: "BootFile",
 		20: "NWACommand",
+		30: "SaveState",
+		31: "Screenshot",
+		32: "FrameAdvance",
+		33: "SetEmulationSpeed",
+		34: "GetEmulationState",
+		35: "BootRom",
+		36: "ListMemoryRegions",
 	}
 	DeviceCapability_value = map[string]int32{
 		"None":                  0,
@@ -202,6 +226,13 @@ var (
 		"GetFile":               15,
 		"BootFile":              16,
 		"NWACommand":            20,
+		"SaveState":             30,
+		"Screenshot":            31,
+		"FrameAdvance":          32,
+		"SetEmulationSpeed":     33,
+		"GetEmulationState":     34,
+		"BootRom":               35,
+		"ListMemoryRegions":     36,
 	}
 )
 
@@ -239,12 +270,22 @@ const (
 	Field_DeviceName    Field = 0
 	Field_DeviceVersion Field = 1
 	Field_DeviceStatus  Field = 2
-	Field_CoreName      Field = 20
-	Field_CoreVersion   Field = 21
-	Field_CorePlatform  Field = 22
-	Field_RomFileName   Field = 40
-	Field_RomHashType   Field = 41
-	Field_RomHashValue  Field = 42
+	// comma-delimited list of feature flags reported by the device, e.g. "FEAT_MSU1,FEAT_CMD_UNLOCK" for FX Pak Pro:
+	Field_DeviceFeatures Field = 3
+	// version of the protocol spoken by the device, e.g. the FX Pak Pro USB protocol version:
+	Field_DeviceProtocolVersion Field = 4
+	Field_CoreName              Field = 20
+	Field_CoreVersion           Field = 21
+	Field_CorePlatform          Field = 22
+	Field_RomFileName           Field = 40
+	Field_RomHashType           Field = 41
+	Field_RomHashValue          Field = 42
+	// comma-delimited list of cart enhancement chips declared by the current ROM's header, e.g. "DSP" or "SA-1":
+	Field_RomChips Field = 43
+	// directories on the emulator's host, e.g. where it keeps save files named after the loaded content:
+	Field_SaveFileDirectory  Field = 60
+	Field_SaveStateDirectory Field = 61
+	Field_SystemDirectory    Field = 62
 )
 
 // Enum value maps for Field.
@@ -253,23 +294,35 @@ var (
 		0:  "DeviceName",
 		1:  "DeviceVersion",
 		2:  "DeviceStatus",
+		3:  "DeviceFeatures",
+		4:  "DeviceProtocolVersion",
 		20: "CoreName",
 		21: "CoreVersion",
 		22: "CorePlatform",
 		40: "RomFileName",
 		41: "RomHashType",
 		42: "RomHashValue",
+		43: "RomChips",
+		60: "SaveFileDirectory",
+		61: "SaveStateDirectory",
+		62: "SystemDirectory",
 	}
 	Field_value = map[string]int32{
-		"DeviceName":    0,
-		"DeviceVersion": 1,
-		"DeviceStatus":  2,
-		"CoreName":      20,
-		"CoreVersion":   21,
-		"CorePlatform":  22,
-		"RomFileName":   40,
-		"RomHashType":   41,
-		"RomHashValue":  42,
+		"DeviceName":            0,
+		"DeviceVersion":         1,
+		"DeviceStatus":          2,
+		"DeviceFeatures":        3,
+		"DeviceProtocolVersion": 4,
+		"CoreName":              20,
+		"CoreVersion":           21,
+		"CorePlatform":          22,
+		"RomFileName":           40,
+		"RomHashType":           41,
+		"RomHashValue":          42,
+		"RomChips":              43,
+		"SaveFileDirectory":     60,
+		"SaveStateDirectory":    61,
+		"SystemDirectory":       62,
 	}
 )
 
@@ -300,6 +353,65 @@ func (Field) EnumDescriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{3}
 }
 
+// normalized state of the emulator or console reported by DeviceInfo.GetEmulationState
+type EmulationState int32
+
+const (
+	// the device cannot report its state:
+	EmulationState_StateUnknown EmulationState = 0
+	// the emulator has no game loaded:
+	EmulationState_NoContent EmulationState = 1
+	// the console is in its menu, e.g. the FX Pak Pro file browser:
+	EmulationState_InMenu  EmulationState = 2
+	EmulationState_Running EmulationState = 3
+	EmulationState_Paused  EmulationState = 4
+)
+
+// Enum value maps for EmulationState.
+var (
+	EmulationState_name = map[int32]string{
+		0: "StateUnknown",
+		1: "NoContent",
+		2: "InMenu",
+		3: "Running",
+		4: "Paused",
+	}
+	EmulationState_value = map[string]int32{
+		"StateUnknown": 0,
+		"NoContent":    1,
+		"InMenu":       2,
+		"Running":      3,
+		"Paused":       4,
+	}
+)
+
+func (x EmulationState) Enum() *EmulationState {
+	p := new(EmulationState)
+	*p = x
+	return p
+}
+
+func (x EmulationState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EmulationState) Descriptor() protoreflect.EnumDescriptor {
+	return file_sni_proto_enumTypes[4].Descriptor()
+}
+
+func (EmulationState) Type() protoreflect.EnumType {
+	return &file_sni_proto_enumTypes[4]
+}
+
+func (x EmulationState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EmulationState.Descriptor instead.
+func (EmulationState) EnumDescriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{4}
+}
+
 type DirEntryType int32
 
 const (
@@ -330,11 +442,11 @@ func (x DirEntryType) String() string {
 }
 
 func (DirEntryType) Descriptor() protoreflect.EnumDescriptor {
-	return file_sni_proto_enumTypes[4].Descriptor()
+	return file_sni_proto_enumTypes[5].Descriptor()
 }
 
 func (DirEntryType) Type() protoreflect.EnumType {
-	return &file_sni_proto_enumTypes[4]
+	return &file_sni_proto_enumTypes[5]
 }
 
 func (x DirEntryType) Number() protoreflect.EnumNumber {
@@ -343,7 +455,7 @@ func (x DirEntryType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DirEntryType.Descriptor instead.
 func (DirEntryType) EnumDescriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{4}
+	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
 type DevicesRequest struct {
@@ -441,6 +553,135 @@ func (x *DevicesResponse) GetDevices() []*DevicesResponse_Device {
 	return nil
 }
 
+type BenchmarkDeviceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// baud rates to try; defaults to all rates the driver supports
+	BaudRates []uint32 `protobuf:"varint,2,rep,packed,name=baudRates,proto3" json:"baudRates,omitempty"`
+	// how long to transfer data at each rate in milliseconds; defaults to 2000
+	DurationMs uint32 `protobuf:"varint,3,opt,name=durationMs,proto3" json:"durationMs,omitempty"`
+}
+
+func (x *BenchmarkDeviceRequest) Reset() {
+	*x = BenchmarkDeviceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkDeviceRequest) ProtoMessage() {}
+
+func (x *BenchmarkDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkDeviceRequest.ProtoReflect.Descriptor instead.
+func (*BenchmarkDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BenchmarkDeviceRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BenchmarkDeviceRequest) GetBaudRates() []uint32 {
+	if x != nil {
+		return x.BaudRates
+	}
+	return nil
+}
+
+func (x *BenchmarkDeviceRequest) GetDurationMs() uint32 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type BenchmarkDeviceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri     string                            `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Results []*BenchmarkDeviceResponse_Result `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	// fastest rate without errors which is now used when opening the device; 0 if no rate worked reliably
+	BestBaudRate uint32 `protobuf:"varint,3,opt,name=bestBaudRate,proto3" json:"bestBaudRate,omitempty"`
+}
+
+func (x *BenchmarkDeviceResponse) Reset() {
+	*x = BenchmarkDeviceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkDeviceResponse) ProtoMessage() {}
+
+func (x *BenchmarkDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkDeviceResponse.ProtoReflect.Descriptor instead.
+func (*BenchmarkDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BenchmarkDeviceResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BenchmarkDeviceResponse) GetResults() []*BenchmarkDeviceResponse_Result {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BenchmarkDeviceResponse) GetBestBaudRate() uint32 {
+	if x != nil {
+		return x.BestBaudRate
+	}
+	return 0
+}
+
 type ResetSystemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -452,7 +693,7 @@ type ResetSystemRequest struct {
 func (x *ResetSystemRequest) Reset() {
 	*x = ResetSystemRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[2]
+		mi := &file_sni_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -465,7 +706,7 @@ func (x *ResetSystemRequest) String() string {
 func (*ResetSystemRequest) ProtoMessage() {}
 
 func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[2]
+	mi := &file_sni_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -478,7 +719,7 @@ func (x *ResetSystemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetSystemRequest.ProtoReflect.Descriptor instead.
 func (*ResetSystemRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{2}
+	return file_sni_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ResetSystemRequest) GetUri() string {
@@ -499,7 +740,7 @@ type ResetSystemResponse struct {
 func (x *ResetSystemResponse) Reset() {
 	*x = ResetSystemResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[3]
+		mi := &file_sni_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -512,7 +753,7 @@ func (x *ResetSystemResponse) String() string {
 func (*ResetSystemResponse) ProtoMessage() {}
 
 func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[3]
+	mi := &file_sni_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -525,7 +766,7 @@ func (x *ResetSystemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetSystemResponse.ProtoReflect.Descriptor instead.
 func (*ResetSystemResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{3}
+	return file_sni_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResetSystemResponse) GetUri() string {
@@ -546,7 +787,7 @@ type ResetToMenuRequest struct {
 func (x *ResetToMenuRequest) Reset() {
 	*x = ResetToMenuRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[4]
+		mi := &file_sni_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +800,7 @@ func (x *ResetToMenuRequest) String() string {
 func (*ResetToMenuRequest) ProtoMessage() {}
 
 func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[4]
+	mi := &file_sni_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +813,7 @@ func (x *ResetToMenuRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetToMenuRequest.ProtoReflect.Descriptor instead.
 func (*ResetToMenuRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{4}
+	return file_sni_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ResetToMenuRequest) GetUri() string {
@@ -593,7 +834,7 @@ type ResetToMenuResponse struct {
 func (x *ResetToMenuResponse) Reset() {
 	*x = ResetToMenuResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[5]
+		mi := &file_sni_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -606,7 +847,7 @@ func (x *ResetToMenuResponse) String() string {
 func (*ResetToMenuResponse) ProtoMessage() {}
 
 func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[5]
+	mi := &file_sni_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +860,7 @@ func (x *ResetToMenuResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetToMenuResponse.ProtoReflect.Descriptor instead.
 func (*ResetToMenuResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{5}
+	return file_sni_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ResetToMenuResponse) GetUri() string {
@@ -642,7 +883,7 @@ type PauseEmulationRequest struct {
 func (x *PauseEmulationRequest) Reset() {
 	*x = PauseEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[6]
+		mi := &file_sni_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -655,7 +896,7 @@ func (x *PauseEmulationRequest) String() string {
 func (*PauseEmulationRequest) ProtoMessage() {}
 
 func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[6]
+	mi := &file_sni_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +909,7 @@ func (x *PauseEmulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseEmulationRequest.ProtoReflect.Descriptor instead.
 func (*PauseEmulationRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{6}
+	return file_sni_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *PauseEmulationRequest) GetUri() string {
@@ -697,7 +938,7 @@ type PauseEmulationResponse struct {
 func (x *PauseEmulationResponse) Reset() {
 	*x = PauseEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[7]
+		mi := &file_sni_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -710,7 +951,7 @@ func (x *PauseEmulationResponse) String() string {
 func (*PauseEmulationResponse) ProtoMessage() {}
 
 func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[7]
+	mi := &file_sni_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,7 +964,7 @@ func (x *PauseEmulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseEmulationResponse.ProtoReflect.Descriptor instead.
 func (*PauseEmulationResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{7}
+	return file_sni_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *PauseEmulationResponse) GetUri() string {
@@ -751,7 +992,7 @@ type PauseToggleEmulationRequest struct {
 func (x *PauseToggleEmulationRequest) Reset() {
 	*x = PauseToggleEmulationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[8]
+		mi := &file_sni_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -764,7 +1005,7 @@ func (x *PauseToggleEmulationRequest) String() string {
 func (*PauseToggleEmulationRequest) ProtoMessage() {}
 
 func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[8]
+	mi := &file_sni_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -777,7 +1018,7 @@ func (x *PauseToggleEmulationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseToggleEmulationRequest.ProtoReflect.Descriptor instead.
 func (*PauseToggleEmulationRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{8}
+	return file_sni_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *PauseToggleEmulationRequest) GetUri() string {
@@ -798,7 +1039,7 @@ type PauseToggleEmulationResponse struct {
 func (x *PauseToggleEmulationResponse) Reset() {
 	*x = PauseToggleEmulationResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[9]
+		mi := &file_sni_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -811,7 +1052,7 @@ func (x *PauseToggleEmulationResponse) String() string {
 func (*PauseToggleEmulationResponse) ProtoMessage() {}
 
 func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[9]
+	mi := &file_sni_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,7 +1065,7 @@ func (x *PauseToggleEmulationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PauseToggleEmulationResponse.ProtoReflect.Descriptor instead.
 func (*PauseToggleEmulationResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{9}
+	return file_sni_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PauseToggleEmulationResponse) GetUri() string {
@@ -834,36 +1075,40 @@ func (x *PauseToggleEmulationResponse) GetUri() string {
 	return ""
 }
 
-type DetectMemoryMappingRequest struct {
+type ExecuteASMRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// optional fallback value to set in case detection fails for some reason
-	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
-	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
-	// if not provided, the header will be read from the device
-	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
+	// assembled 65816 code to run once during the next NMI. The code is called with JSR in bank $00 with 16-bit A, X and
+	// Y, the data bank register set to $00, X pointing to `data` and Y pointing to the result buffer, and must return
+	// with RTS. On FX Pak Pro, the code is located at $00:2C28, the result buffer at $00:2E00 and code and data together
+	// may be at most 472 bytes:
+	Code []byte `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// optional data payload placed directly after the code:
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// number of bytes to read back from the result buffer once the code returned (at most 512):
+	ResultSize uint32 `protobuf:"varint,4,opt,name=resultSize,proto3" json:"resultSize,omitempty"`
 }
 
-func (x *DetectMemoryMappingRequest) Reset() {
-	*x = DetectMemoryMappingRequest{}
+func (x *ExecuteASMRequest) Reset() {
+	*x = ExecuteASMRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[10]
+		mi := &file_sni_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DetectMemoryMappingRequest) String() string {
+func (x *ExecuteASMRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingRequest) ProtoMessage() {}
+func (*ExecuteASMRequest) ProtoMessage() {}
 
-func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[10]
+func (x *ExecuteASMRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,63 +1119,65 @@ func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ExecuteASMRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteASMRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DetectMemoryMappingRequest) GetUri() string {
+func (x *ExecuteASMRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
-	if x != nil && x.FallbackMemoryMapping != nil {
-		return *x.FallbackMemoryMapping
+func (x *ExecuteASMRequest) GetCode() []byte {
+	if x != nil {
+		return x.Code
 	}
-	return MemoryMapping_Unknown
+	return nil
 }
 
-func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
+func (x *ExecuteASMRequest) GetData() []byte {
 	if x != nil {
-		return x.RomHeader00FFB0
+		return x.Data
 	}
 	return nil
 }
 
-type DetectMemoryMappingResponse struct {
+func (x *ExecuteASMRequest) GetResultSize() uint32 {
+	if x != nil {
+		return x.ResultSize
+	}
+	return 0
+}
+
+type ExecuteASMResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// the memory mapping mode detected
-	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
-	// true if confident we detected a mapping; false if using a fallback or default value
-	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
-	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
-	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Result []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *DetectMemoryMappingResponse) Reset() {
-	*x = DetectMemoryMappingResponse{}
+func (x *ExecuteASMResponse) Reset() {
+	*x = ExecuteASMResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[11]
+		mi := &file_sni_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DetectMemoryMappingResponse) String() string {
+func (x *ExecuteASMResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DetectMemoryMappingResponse) ProtoMessage() {}
+func (*ExecuteASMResponse) ProtoMessage() {}
 
-func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[11]
+func (x *ExecuteASMResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,67 +1188,56 @@ func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
-func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ExecuteASMResponse.ProtoReflect.Descriptor instead.
+func (*ExecuteASMResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DetectMemoryMappingResponse) GetUri() string {
+func (x *ExecuteASMResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.MemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *DetectMemoryMappingResponse) GetConfidence() bool {
-	if x != nil {
-		return x.Confidence
-	}
-	return false
-}
-
-func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
+func (x *ExecuteASMResponse) GetResult() []byte {
 	if x != nil {
-		return x.RomHeader00FFB0
+		return x.Result
 	}
 	return nil
 }
 
-type ReadMemoryRequest struct {
+type SaveStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// numbered save state slot of the emulator; used unless path or transfer is set:
+	Slot uint32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// path of a state file on the emulator's host:
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// return the state in the response instead of saving it to a slot or file:
+	Transfer bool `protobuf:"varint,4,opt,name=transfer,proto3" json:"transfer,omitempty"`
 }
 
-func (x *ReadMemoryRequest) Reset() {
-	*x = ReadMemoryRequest{}
+func (x *SaveStateRequest) Reset() {
+	*x = SaveStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[12]
+		mi := &file_sni_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadMemoryRequest) String() string {
+func (x *SaveStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryRequest) ProtoMessage() {}
+func (*SaveStateRequest) ProtoMessage() {}
 
-func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[12]
+func (x *SaveStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,70 +1248,66 @@ func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SaveStateRequest.ProtoReflect.Descriptor instead.
+func (*SaveStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
+func (x *SaveStateRequest) GetUri() string {
 	if x != nil {
-		return x.RequestAddress
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
+func (x *SaveStateRequest) GetSlot() uint32 {
 	if x != nil {
-		return x.RequestAddressSpace
+		return x.Slot
 	}
-	return AddressSpace_FxPakPro
+	return 0
 }
 
-func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+func (x *SaveStateRequest) GetPath() string {
 	if x != nil {
-		return x.RequestMemoryMapping
+		return x.Path
 	}
-	return MemoryMapping_Unknown
+	return ""
 }
 
-func (x *ReadMemoryRequest) GetSize() uint32 {
+func (x *SaveStateRequest) GetTransfer() bool {
 	if x != nil {
-		return x.Size
+		return x.Transfer
 	}
-	return 0
+	return false
 }
 
-type ReadMemoryResponse struct {
+type SaveStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	// the address sent to the device and its space
-	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the state if transfer was requested:
+	State []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *ReadMemoryResponse) Reset() {
-	*x = ReadMemoryResponse{}
+func (x *SaveStateResponse) Reset() {
+	*x = SaveStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[13]
+		mi := &file_sni_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadMemoryResponse) String() string {
+func (x *SaveStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadMemoryResponse) ProtoMessage() {}
+func (*SaveStateResponse) ProtoMessage() {}
 
-func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[13]
+func (x *SaveStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1086,81 +1318,56 @@ func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
+// Deprecated: Use SaveStateResponse.ProtoReflect.Descriptor instead.
+func (*SaveStateResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+func (x *SaveStateResponse) GetUri() string {
 	if x != nil {
-		return x.DeviceAddressSpace
+		return x.Uri
 	}
-	return AddressSpace_FxPakPro
+	return ""
 }
 
-func (x *ReadMemoryResponse) GetData() []byte {
+func (x *SaveStateResponse) GetState() []byte {
 	if x != nil {
-		return x.Data
+		return x.State
 	}
 	return nil
 }
 
-type WriteMemoryRequest struct {
+type LoadStateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// numbered save state slot of the emulator; used unless path or state is set:
+	Slot uint32 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// path of a state file on the emulator's host:
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// state to load, e.g. as returned by SaveState with transfer set:
+	State []byte `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
 }
 
-func (x *WriteMemoryRequest) Reset() {
-	*x = WriteMemoryRequest{}
+func (x *LoadStateRequest) Reset() {
+	*x = LoadStateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[14]
+		mi := &file_sni_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteMemoryRequest) String() string {
+func (x *LoadStateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryRequest) ProtoMessage() {}
+func (*LoadStateRequest) ProtoMessage() {}
 
-func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[14]
+func (x *LoadStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,69 +1378,64 @@ func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use LoadStateRequest.ProtoReflect.Descriptor instead.
+func (*LoadStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
+func (x *LoadStateRequest) GetUri() string {
 	if x != nil {
-		return x.RequestAddress
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
+func (x *LoadStateRequest) GetSlot() uint32 {
 	if x != nil {
-		return x.RequestAddressSpace
+		return x.Slot
 	}
-	return AddressSpace_FxPakPro
+	return 0
 }
 
-func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+func (x *LoadStateRequest) GetPath() string {
 	if x != nil {
-		return x.RequestMemoryMapping
+		return x.Path
 	}
-	return MemoryMapping_Unknown
+	return ""
 }
 
-func (x *WriteMemoryRequest) GetData() []byte {
+func (x *LoadStateRequest) GetState() []byte {
 	if x != nil {
-		return x.Data
+		return x.State
 	}
 	return nil
 }
 
-type WriteMemoryResponse struct {
+type LoadStateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
-	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
-	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
-	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
-	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
-	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *WriteMemoryResponse) Reset() {
-	*x = WriteMemoryResponse{}
+func (x *LoadStateResponse) Reset() {
+	*x = LoadStateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[15]
+		mi := &file_sni_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WriteMemoryResponse) String() string {
+func (x *LoadStateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WriteMemoryResponse) ProtoMessage() {}
+func (*LoadStateResponse) ProtoMessage() {}
 
-func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[15]
+func (x *LoadStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1244,79 +1446,43 @@ func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
-	if x != nil {
-		return x.RequestAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
-	if x != nil {
-		return x.RequestAddressSpace
-	}
-	return AddressSpace_FxPakPro
-}
-
-func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
-	if x != nil {
-		return x.RequestMemoryMapping
-	}
-	return MemoryMapping_Unknown
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
-	if x != nil {
-		return x.DeviceAddress
-	}
-	return 0
-}
-
-func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
-	if x != nil {
-		return x.DeviceAddressSpace
-	}
-	return AddressSpace_FxPakPro
+// Deprecated: Use LoadStateResponse.ProtoReflect.Descriptor instead.
+func (*LoadStateResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *WriteMemoryResponse) GetSize() uint32 {
+func (x *LoadStateResponse) GetUri() string {
 	if x != nil {
-		return x.Size
+		return x.Uri
 	}
-	return 0
+	return ""
 }
 
-type SingleReadMemoryRequest struct {
+type ScreenshotRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleReadMemoryRequest) Reset() {
-	*x = SingleReadMemoryRequest{}
+func (x *ScreenshotRequest) Reset() {
+	*x = ScreenshotRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[16]
+		mi := &file_sni_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleReadMemoryRequest) String() string {
+func (x *ScreenshotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryRequest) ProtoMessage() {}
+func (*ScreenshotRequest) ProtoMessage() {}
 
-func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[16]
+func (x *ScreenshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1327,51 +1493,49 @@ func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ScreenshotRequest.ProtoReflect.Descriptor instead.
+func (*ScreenshotRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SingleReadMemoryRequest) GetUri() string {
+func (x *ScreenshotRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-type SingleReadMemoryResponse struct {
+type ScreenshotResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the captured image if the device can transfer it, e.g. a PNG:
+	Image []byte `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// MIME type of image, e.g. "image/png":
+	MimeType string `protobuf:"bytes,3,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	// path on the emulator's host the image was saved to, or the directory it was saved to if the file name is not known:
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *SingleReadMemoryResponse) Reset() {
-	*x = SingleReadMemoryResponse{}
+func (x *ScreenshotResponse) Reset() {
+	*x = ScreenshotResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[17]
+		mi := &file_sni_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleReadMemoryResponse) String() string {
+func (x *ScreenshotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleReadMemoryResponse) ProtoMessage() {}
+func (*ScreenshotResponse) ProtoMessage() {}
 
-func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[17]
+func (x *ScreenshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1382,51 +1546,66 @@ func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ScreenshotResponse.ProtoReflect.Descriptor instead.
+func (*ScreenshotResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SingleReadMemoryResponse) GetUri() string {
+func (x *ScreenshotResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
+func (x *ScreenshotResponse) GetImage() []byte {
 	if x != nil {
-		return x.Response
+		return x.Image
 	}
 	return nil
 }
 
-type SingleWriteMemoryRequest struct {
+func (x *ScreenshotResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *ScreenshotResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FrameAdvanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// number of frames to advance; 0 is treated as 1:
+	Frames uint32 `protobuf:"varint,2,opt,name=frames,proto3" json:"frames,omitempty"`
 }
 
-func (x *SingleWriteMemoryRequest) Reset() {
-	*x = SingleWriteMemoryRequest{}
+func (x *FrameAdvanceRequest) Reset() {
+	*x = FrameAdvanceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[18]
+		mi := &file_sni_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleWriteMemoryRequest) String() string {
+func (x *FrameAdvanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryRequest) ProtoMessage() {}
+func (*FrameAdvanceRequest) ProtoMessage() {}
 
-func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[18]
+func (x *FrameAdvanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,51 +1616,50 @@ func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use FrameAdvanceRequest.ProtoReflect.Descriptor instead.
+func (*FrameAdvanceRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *SingleWriteMemoryRequest) GetUri() string {
+func (x *FrameAdvanceRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
+func (x *FrameAdvanceRequest) GetFrames() uint32 {
 	if x != nil {
-		return x.Request
+		return x.Frames
 	}
-	return nil
+	return 0
 }
 
-type SingleWriteMemoryResponse struct {
+type FrameAdvanceResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *SingleWriteMemoryResponse) Reset() {
-	*x = SingleWriteMemoryResponse{}
+func (x *FrameAdvanceResponse) Reset() {
+	*x = FrameAdvanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[19]
+		mi := &file_sni_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SingleWriteMemoryResponse) String() string {
+func (x *FrameAdvanceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SingleWriteMemoryResponse) ProtoMessage() {}
+func (*FrameAdvanceResponse) ProtoMessage() {}
 
-func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[19]
+func (x *FrameAdvanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1492,51 +1670,47 @@ func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use FrameAdvanceResponse.ProtoReflect.Descriptor instead.
+func (*FrameAdvanceResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SingleWriteMemoryResponse) GetUri() string {
+func (x *FrameAdvanceResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
-	if x != nil {
-		return x.Response
-	}
-	return nil
-}
-
-type MultiReadMemoryRequest struct {
+type SetEmulationSpeedRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// emulation speed relative to normal, e.g. 2.0 to fast-forward, 0.5 for slow-motion and 1.0 to return to normal.
+	// Emulators that only support toggling fast-forward and slow-motion use their configured rates for any speed above
+	// or below 1.0:
+	Speed float64 `protobuf:"fixed64,2,opt,name=speed,proto3" json:"speed,omitempty"`
 }
 
-func (x *MultiReadMemoryRequest) Reset() {
-	*x = MultiReadMemoryRequest{}
+func (x *SetEmulationSpeedRequest) Reset() {
+	*x = SetEmulationSpeedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[20]
+		mi := &file_sni_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiReadMemoryRequest) String() string {
+func (x *SetEmulationSpeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryRequest) ProtoMessage() {}
+func (*SetEmulationSpeedRequest) ProtoMessage() {}
 
-func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[20]
+func (x *SetEmulationSpeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,51 +1721,50 @@ func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetEmulationSpeedRequest.ProtoReflect.Descriptor instead.
+func (*SetEmulationSpeedRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MultiReadMemoryRequest) GetUri() string {
+func (x *SetEmulationSpeedRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
+func (x *SetEmulationSpeedRequest) GetSpeed() float64 {
 	if x != nil {
-		return x.Requests
+		return x.Speed
 	}
-	return nil
+	return 0
 }
 
-type MultiReadMemoryResponse struct {
+type SetEmulationSpeedResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *MultiReadMemoryResponse) Reset() {
-	*x = MultiReadMemoryResponse{}
+func (x *SetEmulationSpeedResponse) Reset() {
+	*x = SetEmulationSpeedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[21]
+		mi := &file_sni_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiReadMemoryResponse) String() string {
+func (x *SetEmulationSpeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiReadMemoryResponse) ProtoMessage() {}
+func (*SetEmulationSpeedResponse) ProtoMessage() {}
 
-func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[21]
+func (x *SetEmulationSpeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1602,51 +1775,49 @@ func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetEmulationSpeedResponse.ProtoReflect.Descriptor instead.
+func (*SetEmulationSpeedResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MultiReadMemoryResponse) GetUri() string {
+func (x *SetEmulationSpeedResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
-	if x != nil {
-		return x.Responses
-	}
-	return nil
-}
-
-type MultiWriteMemoryRequest struct {
+type BootRomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// contents of the ROM to boot, used unless hostPath is set:
+	Rom []byte `protobuf:"bytes,2,opt,name=rom,proto3" json:"rom,omitempty"`
+	// path of a .sfc or .smc ROM file on SNI's host; only accepted from clients on SNI's host and not by FX Pak Pro:
+	HostPath string `protobuf:"bytes,3,opt,name=hostPath,proto3" json:"hostPath,omitempty"`
+	// file name to give the ROM on the device, e.g. "seed.sfc"; defaults to the base name of hostPath:
+	FileName string `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName,omitempty"`
 }
 
-func (x *MultiWriteMemoryRequest) Reset() {
-	*x = MultiWriteMemoryRequest{}
+func (x *BootRomRequest) Reset() {
+	*x = BootRomRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[22]
+		mi := &file_sni_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiWriteMemoryRequest) String() string {
+func (x *BootRomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryRequest) ProtoMessage() {}
+func (*BootRomRequest) ProtoMessage() {}
 
-func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[22]
+func (x *BootRomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1657,51 +1828,66 @@ func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use BootRomRequest.ProtoReflect.Descriptor instead.
+func (*BootRomRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MultiWriteMemoryRequest) GetUri() string {
+func (x *BootRomRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
+func (x *BootRomRequest) GetRom() []byte {
 	if x != nil {
-		return x.Requests
+		return x.Rom
 	}
 	return nil
 }
 
-type MultiWriteMemoryResponse struct {
+func (x *BootRomRequest) GetHostPath() string {
+	if x != nil {
+		return x.HostPath
+	}
+	return ""
+}
+
+func (x *BootRomRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type BootRomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// path of the booted ROM on the device:
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *MultiWriteMemoryResponse) Reset() {
-	*x = MultiWriteMemoryResponse{}
+func (x *BootRomResponse) Reset() {
+	*x = BootRomResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[23]
+		mi := &file_sni_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MultiWriteMemoryResponse) String() string {
+func (x *BootRomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiWriteMemoryResponse) ProtoMessage() {}
+func (*BootRomResponse) ProtoMessage() {}
 
-func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[23]
+func (x *BootRomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1712,51 +1898,55 @@ func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
-func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use BootRomResponse.ProtoReflect.Descriptor instead.
+func (*BootRomResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MultiWriteMemoryResponse) GetUri() string {
+func (x *BootRomResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
+func (x *BootRomResponse) GetPath() string {
 	if x != nil {
-		return x.Responses
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type ReadDirectoryRequest struct {
+type DetectMemoryMappingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional fallback value to set in case detection fails for some reason
+	FallbackMemoryMapping *MemoryMapping `protobuf:"varint,2,opt,name=fallbackMemoryMapping,proto3,enum=MemoryMapping,oneof" json:"fallbackMemoryMapping,omitempty"`
+	// optional ROM header (from bus address $00:FFB0, at least $30 bytes long) to use for detection
+	// if not provided, the header will be read from the device
+	RomHeader00FFB0 []byte `protobuf:"bytes,3,opt,name=romHeader00FFB0,proto3,oneof" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *ReadDirectoryRequest) Reset() {
-	*x = ReadDirectoryRequest{}
+func (x *DetectMemoryMappingRequest) Reset() {
+	*x = DetectMemoryMappingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[24]
+		mi := &file_sni_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirectoryRequest) String() string {
+func (x *DetectMemoryMappingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryRequest) ProtoMessage() {}
+func (*DetectMemoryMappingRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[24]
+func (x *DetectMemoryMappingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1767,51 +1957,63 @@ func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use DetectMemoryMappingRequest.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ReadDirectoryRequest) GetUri() string {
+func (x *DetectMemoryMappingRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *ReadDirectoryRequest) GetPath() string {
+func (x *DetectMemoryMappingRequest) GetFallbackMemoryMapping() MemoryMapping {
+	if x != nil && x.FallbackMemoryMapping != nil {
+		return *x.FallbackMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *DetectMemoryMappingRequest) GetRomHeader00FFB0() []byte {
 	if x != nil {
-		return x.Path
+		return x.RomHeader00FFB0
 	}
-	return ""
+	return nil
 }
 
-type DirEntry struct {
+type DetectMemoryMappingResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// the memory mapping mode detected
+	MemoryMapping MemoryMapping `protobuf:"varint,2,opt,name=memoryMapping,proto3,enum=MemoryMapping" json:"memoryMapping,omitempty"`
+	// true if confident we detected a mapping; false if using a fallback or default value
+	Confidence bool `protobuf:"varint,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// the ROM header read from $00:FFB0, length is $50 bytes if server reads it, otherwise length of `romHeader00FFB0` from request
+	RomHeader00FFB0 []byte `protobuf:"bytes,4,opt,name=romHeader00FFB0,proto3" json:"romHeader00FFB0,omitempty"`
 }
 
-func (x *DirEntry) Reset() {
-	*x = DirEntry{}
+func (x *DetectMemoryMappingResponse) Reset() {
+	*x = DetectMemoryMappingResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[25]
+		mi := &file_sni_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DirEntry) String() string {
+func (x *DetectMemoryMappingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirEntry) ProtoMessage() {}
+func (*DetectMemoryMappingResponse) ProtoMessage() {}
 
-func (x *DirEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[25]
+func (x *DetectMemoryMappingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,52 +2024,64 @@ func (x *DirEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
-func (*DirEntry) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use DetectMemoryMappingResponse.ProtoReflect.Descriptor instead.
+func (*DetectMemoryMappingResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *DirEntry) GetName() string {
+func (x *DetectMemoryMappingResponse) GetUri() string {
 	if x != nil {
-		return x.Name
+		return x.Uri
 	}
 	return ""
 }
 
-func (x *DirEntry) GetType() DirEntryType {
+func (x *DetectMemoryMappingResponse) GetMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.Type
+		return x.MemoryMapping
 	}
-	return DirEntryType_Directory
+	return MemoryMapping_Unknown
 }
 
-type ReadDirectoryResponse struct {
+func (x *DetectMemoryMappingResponse) GetConfidence() bool {
+	if x != nil {
+		return x.Confidence
+	}
+	return false
+}
+
+func (x *DetectMemoryMappingResponse) GetRomHeader00FFB0() []byte {
+	if x != nil {
+		return x.RomHeader00FFB0
+	}
+	return nil
+}
+
+type ListMemoryRegionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
 }
 
-func (x *ReadDirectoryResponse) Reset() {
-	*x = ReadDirectoryResponse{}
+func (x *ListMemoryRegionsRequest) Reset() {
+	*x = ListMemoryRegionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[26]
+		mi := &file_sni_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadDirectoryResponse) String() string {
+func (x *ListMemoryRegionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadDirectoryResponse) ProtoMessage() {}
+func (*ListMemoryRegionsRequest) ProtoMessage() {}
 
-func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[26]
+func (x *ListMemoryRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1878,58 +2092,51 @@ func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListMemoryRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMemoryRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ReadDirectoryResponse) GetUri() string {
+func (x *ListMemoryRegionsRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *ReadDirectoryResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
-	if x != nil {
-		return x.Entries
-	}
-	return nil
-}
-
-type MakeDirectoryRequest struct {
+type MemoryRegion struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// name of the region, e.g. "WRAM", "SRAM", "CARTROM" or "VRAM":
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// address space and address of the start of the region for DeviceMemory reads and writes:
+	AddressSpace AddressSpace `protobuf:"varint,2,opt,name=addressSpace,proto3,enum=AddressSpace" json:"addressSpace,omitempty"`
+	Address      uint32       `protobuf:"varint,3,opt,name=address,proto3" json:"address,omitempty"`
+	// size of the region in bytes:
+	Size     uint32 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Readable bool   `protobuf:"varint,5,opt,name=readable,proto3" json:"readable,omitempty"`
+	Writable bool   `protobuf:"varint,6,opt,name=writable,proto3" json:"writable,omitempty"`
 }
 
-func (x *MakeDirectoryRequest) Reset() {
-	*x = MakeDirectoryRequest{}
+func (x *MemoryRegion) Reset() {
+	*x = MemoryRegion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[27]
+		mi := &file_sni_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MakeDirectoryRequest) String() string {
+func (x *MemoryRegion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MakeDirectoryRequest) ProtoMessage() {}
+func (*MemoryRegion) ProtoMessage() {}
 
-func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[27]
+func (x *MemoryRegion) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1940,106 +2147,79 @@ func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use MemoryRegion.ProtoReflect.Descriptor instead.
+func (*MemoryRegion) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MakeDirectoryRequest) GetUri() string {
+func (x *MemoryRegion) GetName() string {
 	if x != nil {
-		return x.Uri
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MakeDirectoryRequest) GetPath() string {
+func (x *MemoryRegion) GetAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.AddressSpace
 	}
-	return ""
-}
-
-type MakeDirectoryResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	return AddressSpace_FxPakPro
 }
 
-func (x *MakeDirectoryResponse) Reset() {
-	*x = MakeDirectoryResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *MemoryRegion) GetAddress() uint32 {
+	if x != nil {
+		return x.Address
 	}
+	return 0
 }
 
-func (x *MakeDirectoryResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MakeDirectoryResponse) ProtoMessage() {}
-
-func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *MemoryRegion) GetSize() uint32 {
+	if x != nil {
+		return x.Size
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{28}
+	return 0
 }
 
-func (x *MakeDirectoryResponse) GetUri() string {
+func (x *MemoryRegion) GetReadable() bool {
 	if x != nil {
-		return x.Uri
+		return x.Readable
 	}
-	return ""
+	return false
 }
 
-func (x *MakeDirectoryResponse) GetPath() string {
+func (x *MemoryRegion) GetWritable() bool {
 	if x != nil {
-		return x.Path
+		return x.Writable
 	}
-	return ""
+	return false
 }
 
-type RemoveFileRequest struct {
+type ListMemoryRegionsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string          `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Regions []*MemoryRegion `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`
 }
 
-func (x *RemoveFileRequest) Reset() {
-	*x = RemoveFileRequest{}
+func (x *ListMemoryRegionsResponse) Reset() {
+	*x = ListMemoryRegionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[29]
+		mi := &file_sni_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveFileRequest) String() string {
+func (x *ListMemoryRegionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileRequest) ProtoMessage() {}
+func (*ListMemoryRegionsResponse) ProtoMessage() {}
 
-func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[29]
+func (x *ListMemoryRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2050,51 +2230,53 @@ func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
-func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListMemoryRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMemoryRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoveFileRequest) GetUri() string {
+func (x *ListMemoryRegionsResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *RemoveFileRequest) GetPath() string {
+func (x *ListMemoryRegionsResponse) GetRegions() []*MemoryRegion {
 	if x != nil {
-		return x.Path
+		return x.Regions
 	}
-	return ""
+	return nil
 }
 
-type RemoveFileResponse struct {
+type ReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Size                 uint32        `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *RemoveFileResponse) Reset() {
-	*x = RemoveFileResponse{}
+func (x *ReadMemoryRequest) Reset() {
+	*x = ReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[30]
+		mi := &file_sni_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveFileResponse) String() string {
+func (x *ReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveFileResponse) ProtoMessage() {}
+func (*ReadMemoryRequest) ProtoMessage() {}
 
-func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[30]
+func (x *ReadMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2105,52 +2287,70 @@ func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
-func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *RemoveFileResponse) GetUri() string {
+func (x *ReadMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *RemoveFileResponse) GetPath() string {
+func (x *ReadMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-type RenameFileRequest struct {
+func (x *ReadMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
+	if x != nil {
+		return x.RequestMemoryMapping
+	}
+	return MemoryMapping_Unknown
+}
+
+func (x *ReadMemoryRequest) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	// the address sent to the device and its space
+	DeviceAddress      uint32       `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace AddressSpace `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Data               []byte       `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *RenameFileRequest) Reset() {
-	*x = RenameFileRequest{}
+func (x *ReadMemoryResponse) Reset() {
+	*x = ReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[31]
+		mi := &file_sni_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileRequest) String() string {
+func (x *ReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileRequest) ProtoMessage() {}
+func (*ReadMemoryResponse) ProtoMessage() {}
 
-func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[31]
+func (x *ReadMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2161,59 +2361,81 @@ func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
-func (*RenameFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RenameFileRequest) GetUri() string {
+func (x *ReadMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameFileRequest) GetPath() string {
+func (x *ReadMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-func (x *RenameFileRequest) GetNewFilename() string {
+func (x *ReadMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.NewFilename
+		return x.RequestMemoryMapping
 	}
-	return ""
+	return MemoryMapping_Unknown
 }
 
-type RenameFileResponse struct {
+func (x *ReadMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
+	}
+	return 0
+}
+
+func (x *ReadMemoryResponse) GetDeviceAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DeviceAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+func (x *ReadMemoryResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,4,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	Data                 []byte        `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *RenameFileResponse) Reset() {
-	*x = RenameFileResponse{}
+func (x *WriteMemoryRequest) Reset() {
+	*x = WriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[32]
+		mi := &file_sni_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameFileResponse) String() string {
+func (x *WriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameFileResponse) ProtoMessage() {}
+func (*WriteMemoryRequest) ProtoMessage() {}
 
-func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[32]
+func (x *WriteMemoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,59 +2446,71 @@ func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
-func (*RenameFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use WriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*WriteMemoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *RenameFileResponse) GetUri() string {
+func (x *WriteMemoryRequest) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *RenameFileResponse) GetPath() string {
+func (x *WriteMemoryRequest) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-func (x *RenameFileResponse) GetNewFilename() string {
+func (x *WriteMemoryRequest) GetRequestMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.NewFilename
+		return x.RequestMemoryMapping
 	}
-	return ""
+	return MemoryMapping_Unknown
 }
 
-type PutFileRequest struct {
+func (x *WriteMemoryRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	RequestAddress       uint32        `protobuf:"varint,1,opt,name=requestAddress,proto3" json:"requestAddress,omitempty"`
+	RequestAddressSpace  AddressSpace  `protobuf:"varint,2,opt,name=requestAddressSpace,proto3,enum=AddressSpace" json:"requestAddressSpace,omitempty"`
+	RequestMemoryMapping MemoryMapping `protobuf:"varint,6,opt,name=requestMemoryMapping,proto3,enum=MemoryMapping" json:"requestMemoryMapping,omitempty"`
+	DeviceAddress        uint32        `protobuf:"varint,3,opt,name=deviceAddress,proto3" json:"deviceAddress,omitempty"`
+	DeviceAddressSpace   AddressSpace  `protobuf:"varint,4,opt,name=deviceAddressSpace,proto3,enum=AddressSpace" json:"deviceAddressSpace,omitempty"`
+	Size                 uint32        `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	// only set for verified writes: describes how the data read back after the write differs from the data written:
+	VerifyError string `protobuf:"bytes,7,opt,name=verifyError,proto3" json:"verifyError,omitempty"`
 }
 
-func (x *PutFileRequest) Reset() {
-	*x = PutFileRequest{}
+func (x *WriteMemoryResponse) Reset() {
+	*x = WriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[33]
+		mi := &file_sni_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PutFileRequest) String() string {
+func (x *WriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PutFileRequest) ProtoMessage() {}
+func (*WriteMemoryResponse) ProtoMessage() {}
 
-func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[33]
+func (x *WriteMemoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,106 +2521,71 @@ func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
-func (*PutFileRequest) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use WriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*WriteMemoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *PutFileRequest) GetUri() string {
+func (x *WriteMemoryResponse) GetRequestAddress() uint32 {
 	if x != nil {
-		return x.Uri
+		return x.RequestAddress
 	}
-	return ""
+	return 0
 }
 
-func (x *PutFileRequest) GetPath() string {
+func (x *WriteMemoryResponse) GetRequestAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Path
+		return x.RequestAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-func (x *PutFileRequest) GetData() []byte {
+func (x *WriteMemoryResponse) GetRequestMemoryMapping() MemoryMapping {
 	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type PutFileResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-}
-
-func (x *PutFileResponse) Reset() {
-	*x = PutFileResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		return x.RequestMemoryMapping
 	}
+	return MemoryMapping_Unknown
 }
 
-func (x *PutFileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*PutFileResponse) ProtoMessage() {}
-
-func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *WriteMemoryResponse) GetDeviceAddress() uint32 {
+	if x != nil {
+		return x.DeviceAddress
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
-func (*PutFileResponse) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{34}
+	return 0
 }
 
-func (x *PutFileResponse) GetUri() string {
+func (x *WriteMemoryResponse) GetDeviceAddressSpace() AddressSpace {
 	if x != nil {
-		return x.Uri
+		return x.DeviceAddressSpace
 	}
-	return ""
+	return AddressSpace_FxPakPro
 }
 
-func (x *PutFileResponse) GetPath() string {
+func (x *WriteMemoryResponse) GetSize() uint32 {
 	if x != nil {
-		return x.Path
+		return x.Size
 	}
-	return ""
+	return 0
 }
 
-func (x *PutFileResponse) GetSize() uint32 {
+func (x *WriteMemoryResponse) GetVerifyError() string {
 	if x != nil {
-		return x.Size
+		return x.VerifyError
 	}
-	return 0
+	return ""
 }
 
-type GetFileRequest struct {
+type SingleReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *ReadMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *GetFileRequest) Reset() {
-	*x = GetFileRequest{}
+func (x *SingleReadMemoryRequest) Reset() {
+	*x = SingleReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2394,13 +2593,13 @@ func (x *GetFileRequest) Reset() {
 	}
 }
 
-func (x *GetFileRequest) String() string {
+func (x *SingleReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileRequest) ProtoMessage() {}
+func (*SingleReadMemoryRequest) ProtoMessage() {}
 
-func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2412,38 +2611,36 @@ func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
-func (*GetFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetFileRequest) GetUri() string {
+func (x *SingleReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *GetFileRequest) GetPath() string {
+func (x *SingleReadMemoryRequest) GetRequest() *ReadMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type GetFileResponse struct {
+type SingleReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
-	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Uri      string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *ReadMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *GetFileResponse) Reset() {
-	*x = GetFileResponse{}
+func (x *SingleReadMemoryResponse) Reset() {
+	*x = SingleReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2451,13 +2648,13 @@ func (x *GetFileResponse) Reset() {
 	}
 }
 
-func (x *GetFileResponse) String() string {
+func (x *SingleReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileResponse) ProtoMessage() {}
+func (*SingleReadMemoryResponse) ProtoMessage() {}
 
-func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2469,50 +2666,36 @@ func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
-func (*GetFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetFileResponse) GetUri() string {
+func (x *SingleReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *GetFileResponse) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *GetFileResponse) GetSize() uint32 {
-	if x != nil {
-		return x.Size
-	}
-	return 0
-}
-
-func (x *GetFileResponse) GetData() []byte {
+func (x *SingleReadMemoryResponse) GetResponse() *ReadMemoryResponse {
 	if x != nil {
-		return x.Data
+		return x.Response
 	}
 	return nil
 }
 
-type BootFileRequest struct {
+type SingleWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri     string              `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Request *WriteMemoryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
 }
 
-func (x *BootFileRequest) Reset() {
-	*x = BootFileRequest{}
+func (x *SingleWriteMemoryRequest) Reset() {
+	*x = SingleWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2520,13 +2703,13 @@ func (x *BootFileRequest) Reset() {
 	}
 }
 
-func (x *BootFileRequest) String() string {
+func (x *SingleWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileRequest) ProtoMessage() {}
+func (*SingleWriteMemoryRequest) ProtoMessage() {}
 
-func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2538,36 +2721,36 @@ func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
-func (*BootFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BootFileRequest) GetUri() string {
+func (x *SingleWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileRequest) GetPath() string {
+func (x *SingleWriteMemoryRequest) GetRequest() *WriteMemoryRequest {
 	if x != nil {
-		return x.Path
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-type BootFileResponse struct {
+type SingleWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Response *WriteMemoryResponse `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
 }
 
-func (x *BootFileResponse) Reset() {
-	*x = BootFileResponse{}
+func (x *SingleWriteMemoryResponse) Reset() {
+	*x = SingleWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2575,13 +2758,13 @@ func (x *BootFileResponse) Reset() {
 	}
 }
 
-func (x *BootFileResponse) String() string {
+func (x *SingleWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BootFileResponse) ProtoMessage() {}
+func (*SingleWriteMemoryResponse) ProtoMessage() {}
 
-func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+func (x *SingleWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2593,36 +2776,39 @@ func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
-func (*BootFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SingleWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*SingleWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *BootFileResponse) GetUri() string {
+func (x *SingleWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *BootFileResponse) GetPath() string {
+func (x *SingleWriteMemoryResponse) GetResponse() *WriteMemoryResponse {
 	if x != nil {
-		return x.Path
+		return x.Response
 	}
-	return ""
+	return nil
 }
 
-type FieldsRequest struct {
+type MultiReadMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Uri      string               `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*ReadMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// only used by StreamRead: keep sending responses for these requests as new data arrives (natively streamed by the
+	// device if supported, else polled once per frame) until the client sends its next request or closes the stream:
+	Subscribe bool `protobuf:"varint,3,opt,name=subscribe,proto3" json:"subscribe,omitempty"`
 }
 
-func (x *FieldsRequest) Reset() {
-	*x = FieldsRequest{}
+func (x *MultiReadMemoryRequest) Reset() {
+	*x = MultiReadMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2630,13 +2816,13 @@ func (x *FieldsRequest) Reset() {
 	}
 }
 
-func (x *FieldsRequest) String() string {
+func (x *MultiReadMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsRequest) ProtoMessage() {}
+func (*MultiReadMemoryRequest) ProtoMessage() {}
 
-func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2648,37 +2834,43 @@ func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
-func (*FieldsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *FieldsRequest) GetUri() string {
+func (x *MultiReadMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *FieldsRequest) GetFields() []Field {
+func (x *MultiReadMemoryRequest) GetRequests() []*ReadMemoryRequest {
 	if x != nil {
-		return x.Fields
+		return x.Requests
 	}
 	return nil
 }
 
-type FieldsResponse struct {
+func (x *MultiReadMemoryRequest) GetSubscribe() bool {
+	if x != nil {
+		return x.Subscribe
+	}
+	return false
+}
+
+type MultiReadMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
-	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Uri       string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*ReadMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *FieldsResponse) Reset() {
-	*x = FieldsResponse{}
+func (x *MultiReadMemoryResponse) Reset() {
+	*x = MultiReadMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2686,13 +2878,13 @@ func (x *FieldsResponse) Reset() {
 	}
 }
 
-func (x *FieldsResponse) String() string {
+func (x *MultiReadMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FieldsResponse) ProtoMessage() {}
+func (*MultiReadMemoryResponse) ProtoMessage() {}
 
-func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiReadMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2704,48 +2896,39 @@ func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
-func (*FieldsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiReadMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiReadMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *FieldsResponse) GetUri() string {
+func (x *MultiReadMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *FieldsResponse) GetFields() []Field {
-	if x != nil {
-		return x.Fields
-	}
-	return nil
-}
-
-func (x *FieldsResponse) GetValues() []string {
+func (x *MultiReadMemoryResponse) GetResponses() []*ReadMemoryResponse {
 	if x != nil {
-		return x.Values
+		return x.Responses
 	}
 	return nil
 }
 
-type NWACommandRequest struct {
+type MultiWriteMemoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// emu-nwaccess command name:
-	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	// command arguments:
-	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
-	// an optional binary argument:
-	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
+	Uri      string                `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Requests []*WriteMemoryRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
+	// read back every written range after the writes and report mismatches in each response's `verifyError`. The
+	// read-back is not atomic with the writes, so only verify memory the game and other clients do not write to:
+	Verify bool `protobuf:"varint,3,opt,name=verify,proto3" json:"verify,omitempty"`
 }
 
-func (x *NWACommandRequest) Reset() {
-	*x = NWACommandRequest{}
+func (x *MultiWriteMemoryRequest) Reset() {
+	*x = MultiWriteMemoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2753,13 +2936,13 @@ func (x *NWACommandRequest) Reset() {
 	}
 }
 
-func (x *NWACommandRequest) String() string {
+func (x *MultiWriteMemoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandRequest) ProtoMessage() {}
+func (*MultiWriteMemoryRequest) ProtoMessage() {}
 
-func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2771,51 +2954,43 @@ func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
-func (*NWACommandRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryRequest.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryRequest) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *NWACommandRequest) GetUri() string {
+func (x *MultiWriteMemoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *NWACommandRequest) GetCommand() string {
-	if x != nil {
-		return x.Command
-	}
-	return ""
-}
-
-func (x *NWACommandRequest) GetArgs() string {
+func (x *MultiWriteMemoryRequest) GetRequests() []*WriteMemoryRequest {
 	if x != nil {
-		return x.Args
+		return x.Requests
 	}
-	return ""
+	return nil
 }
 
-func (x *NWACommandRequest) GetBinaryArg() []byte {
+func (x *MultiWriteMemoryRequest) GetVerify() bool {
 	if x != nil {
-		return x.BinaryArg
+		return x.Verify
 	}
-	return nil
+	return false
 }
 
-type NWACommandResponse struct {
+type MultiWriteMemoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
-	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+	Uri       string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Responses []*WriteMemoryResponse `protobuf:"bytes,2,rep,name=responses,proto3" json:"responses,omitempty"`
 }
 
-func (x *NWACommandResponse) Reset() {
-	*x = NWACommandResponse{}
+func (x *MultiWriteMemoryResponse) Reset() {
+	*x = MultiWriteMemoryResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2823,13 +2998,13 @@ func (x *NWACommandResponse) Reset() {
 	}
 }
 
-func (x *NWACommandResponse) String() string {
+func (x *MultiWriteMemoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse) ProtoMessage() {}
+func (*MultiWriteMemoryResponse) ProtoMessage() {}
 
-func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
+func (x *MultiWriteMemoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2841,61 +3016,36 @@ func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use MultiWriteMemoryResponse.ProtoReflect.Descriptor instead.
+func (*MultiWriteMemoryResponse) Descriptor() ([]byte, []int) {
 	return file_sni_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *NWACommandResponse) GetUri() string {
+func (x *MultiWriteMemoryResponse) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
-	if x != nil {
-		return x.AsciiReply
-	}
-	return nil
-}
-
-func (x *NWACommandResponse) GetBinaryReplay() []byte {
+func (x *MultiWriteMemoryResponse) GetResponses() []*WriteMemoryResponse {
 	if x != nil {
-		return x.BinaryReplay
+		return x.Responses
 	}
 	return nil
 }
 
-type DevicesResponse_Device struct {
+type ReadDirectoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// URI that describes exactly how to connect to the device, e.g.:
-	// RetroArch:  "ra://127.0.0.1:55355"
-	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
-	//             "fxpakpro://./COM4"                         (Windows)
-	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
-	// uri is used as the unique identifier of the device for clients to refer to
-	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	// friendly display name of the device
-	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
-	// device kind, e.g. "fxpakpro", "retroarch", "lua"
-	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
-	// all device capabilities:
-	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
-	// default address space for the device:
-	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
-	// [DEPRECATED] console system supported, e.g. "snes", "n64"
-	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
-	//
-	// Deprecated: Do not use.
-	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *DevicesResponse_Device) Reset() {
-	*x = DevicesResponse_Device{}
+func (x *ReadDirectoryRequest) Reset() {
+	*x = ReadDirectoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_sni_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2903,13 +3053,13 @@ func (x *DevicesResponse_Device) Reset() {
 	}
 }
 
-func (x *DevicesResponse_Device) String() string {
+func (x *ReadDirectoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DevicesResponse_Device) ProtoMessage() {}
+func (*ReadDirectoryRequest) ProtoMessage() {}
 
-func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+func (x *ReadDirectoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_sni_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2921,685 +3071,4778 @@ func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
-func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ReadDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *DevicesResponse_Device) GetUri() string {
+func (x *ReadDirectoryRequest) GetUri() string {
 	if x != nil {
 		return x.Uri
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetDisplayName() string {
+func (x *ReadDirectoryRequest) GetPath() string {
 	if x != nil {
-		return x.DisplayName
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DevicesResponse_Device) GetKind() string {
-	if x != nil {
-		return x.Kind
+type DirEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+}
+
+func (x *DirEntry) Reset() {
+	*x = DirEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
-	if x != nil {
-		return x.Capabilities
+func (x *DirEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirEntry) ProtoMessage() {}
+
+func (x *DirEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+// Deprecated: Use DirEntry.ProtoReflect.Descriptor instead.
+func (*DirEntry) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DirEntry) GetName() string {
 	if x != nil {
-		return x.DefaultAddressSpace
+		return x.Name
 	}
-	return AddressSpace_FxPakPro
+	return ""
 }
 
-// Deprecated: Do not use.
-func (x *DevicesResponse_Device) GetSystem() string {
+func (x *DirEntry) GetType() DirEntryType {
 	if x != nil {
-		return x.System
+		return x.Type
 	}
-	return ""
+	return DirEntryType_Directory
 }
 
-type NWACommandResponse_NWAASCIIItem struct {
+type ReadDirectoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Uri     string      `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path    string      `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Entries []*DirEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) Reset() {
-	*x = NWACommandResponse_NWAASCIIItem{}
+func (x *ReadDirectoryResponse) Reset() {
+	*x = ReadDirectoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_sni_proto_msgTypes[44]
+		mi := &file_sni_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NWACommandResponse_NWAASCIIItem) String() string {
+func (x *ReadDirectoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+func (*ReadDirectoryResponse) ProtoMessage() {}
 
-func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sni_proto_msgTypes[44]
+func (x *ReadDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ReadDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReadDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ReadDirectoryResponse) GetEntries() []*DirEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MakeDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryRequest) Reset() {
+	*x = MakeDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryRequest) ProtoMessage() {}
+
+func (x *MakeDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MakeDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type MakeDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *MakeDirectoryResponse) Reset() {
+	*x = MakeDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MakeDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MakeDirectoryResponse) ProtoMessage() {}
+
+func (x *MakeDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MakeDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*MakeDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MakeDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MakeDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileRequest) Reset() {
+	*x = RemoveFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileRequest) ProtoMessage() {}
+
+func (x *RemoveFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RemoveFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveFileResponse) Reset() {
+	*x = RemoveFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFileResponse) ProtoMessage() {}
+
+func (x *RemoveFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
-func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
-	return file_sni_proto_rawDescGZIP(), []int{42, 0}
-}
-
-func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
-	if x != nil {
-		return x.Item
-	}
-	return nil
-}
-
-var File_sni_proto protoreflect.FileDescriptor
-
-var file_sni_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
-	0x6e, 0x64, 0x73, 0x22, 0xab, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xe4, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
-	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
-	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e,
-	0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
-	0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b, 0x50, 0x61,
-	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a, 0x1c, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xd6, 0x01,
-	0x0a, 0x1a, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x49,
-	0x0a, 0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52,
-	0x15, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
-	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x6f, 0x6d,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x48, 0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
-	0x30, 0x46, 0x46, 0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x66, 0x61, 0x6c,
-	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
-	0x6e, 0x67, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63,
-	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
-	0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28,
-	0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42,
-	0x30, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x61,
-	0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
-	0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
-	0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22,
-	0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f,
-	0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x01, 0x0a,
-	0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
-	0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
-	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x22, 0xbb, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a,
-	0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x0a, 0x18,
-	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x53, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5a, 0x0a, 0x16, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
-	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57,
-	0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x73, 0x22, 0x60, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d,
-	0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b,
-	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65,
-	0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12,
-	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46,
-	0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e,
-	0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x4a, 0x0a, 0x0e, 0x50, 0x75,
-	0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
-	0x69, 0x7a, 0x65, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5f, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x0f,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
-	0x41, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x84,
-	0x01, 0x0a, 0x11, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x61, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72,
-	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72,
-	0x79, 0x41, 0x72, 0x67, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61,
-	0x72, 0x79, 0x41, 0x72, 0x67, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40,
-	0x0a, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x27, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79,
-	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74,
-	0x65, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57,
-	0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74,
-	0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x61, 0x79, 0x2a, 0x33, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f,
-	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01,
-	0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02, 0x2a, 0x48, 0x0a, 0x0d, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
-	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x48, 0x69, 0x52, 0x4f, 0x4d,
-	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x52, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x0b, 0x0a,
-	0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x41,
-	0x31, 0x10, 0x04, 0x2a, 0xb3, 0x02, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61,
-	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x6f, 0x6e, 0x65,
-	0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53,
-	0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70,
-	0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x05, 0x12,
-	0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73,
-	0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x65,
-	0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x0a, 0x12, 0x11,
-	0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10,
-	0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x10,
-	0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0e, 0x12, 0x0b,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f, 0x12, 0x0c, 0x0a, 0x08, 0x42,
-	0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x57, 0x41,
-	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x2a, 0xa1, 0x01, 0x0a, 0x05, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x6f, 0x72, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x6f, 0x72, 0x65, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x6f, 0x72, 0x65, 0x50,
-	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d,
-	0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f,
-	0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65, 0x10, 0x29, 0x12, 0x10, 0x0a, 0x0c, 0x52,
-	0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x10, 0x2a, 0x2a, 0x27, 0x0a,
-	0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d, 0x0a,
-	0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
-	0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x3d, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xaa, 0x02, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79,
-	0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65,
-	0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65,
-	0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54,
-	0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65,
-	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50,
-	0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c,
-	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45,
-	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x32, 0x81, 0x04, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d,
-	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x12,
-	0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67,
-	0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72,
-	0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64,
-	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18,
-	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
-	0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d,
-	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x48, 0x0a, 0x0b,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69,
-	0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0x9b, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15,
-	0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61,
-	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x50,
-	0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x47,
-	0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
-	0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x10, 0x2e,
-	0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x3e, 0x0a, 0x0a, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e,
-	0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x32, 0x44, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x57,
-	0x41, 0x12, 0x37, 0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
-	0x12, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x0a, 0x15, 0x63, 0x6f,
-	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2e,
-	0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_sni_proto_rawDescOnce sync.Once
-	file_sni_proto_rawDescData = file_sni_proto_rawDesc
-)
-
-func file_sni_proto_rawDescGZIP() []byte {
-	file_sni_proto_rawDescOnce.Do(func() {
-		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
-	})
-	return file_sni_proto_rawDescData
-}
-
-var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_sni_proto_goTypes = []interface{}{
-	(AddressSpace)(0),                       // 0: AddressSpace
-	(MemoryMapping)(0),                      // 1: MemoryMapping
-	(DeviceCapability)(0),                   // 2: DeviceCapability
-	(Field)(0),                              // 3: Field
-	(DirEntryType)(0),                       // 4: DirEntryType
-	(*DevicesRequest)(nil),                  // 5: DevicesRequest
-	(*DevicesResponse)(nil),                 // 6: DevicesResponse
-	(*ResetSystemRequest)(nil),              // 7: ResetSystemRequest
-	(*ResetSystemResponse)(nil),             // 8: ResetSystemResponse
-	(*ResetToMenuRequest)(nil),              // 9: ResetToMenuRequest
-	(*ResetToMenuResponse)(nil),             // 10: ResetToMenuResponse
-	(*PauseEmulationRequest)(nil),           // 11: PauseEmulationRequest
-	(*PauseEmulationResponse)(nil),          // 12: PauseEmulationResponse
-	(*PauseToggleEmulationRequest)(nil),     // 13: PauseToggleEmulationRequest
-	(*PauseToggleEmulationResponse)(nil),    // 14: PauseToggleEmulationResponse
-	(*DetectMemoryMappingRequest)(nil),      // 15: DetectMemoryMappingRequest
-	(*DetectMemoryMappingResponse)(nil),     // 16: DetectMemoryMappingResponse
-	(*ReadMemoryRequest)(nil),               // 17: ReadMemoryRequest
-	(*ReadMemoryResponse)(nil),              // 18: ReadMemoryResponse
-	(*WriteMemoryRequest)(nil),              // 19: WriteMemoryRequest
-	(*WriteMemoryResponse)(nil),             // 20: WriteMemoryResponse
-	(*SingleReadMemoryRequest)(nil),         // 21: SingleReadMemoryRequest
-	(*SingleReadMemoryResponse)(nil),        // 22: SingleReadMemoryResponse
-	(*SingleWriteMemoryRequest)(nil),        // 23: SingleWriteMemoryRequest
-	(*SingleWriteMemoryResponse)(nil),       // 24: SingleWriteMemoryResponse
-	(*MultiReadMemoryRequest)(nil),          // 25: MultiReadMemoryRequest
-	(*MultiReadMemoryResponse)(nil),         // 26: MultiReadMemoryResponse
-	(*MultiWriteMemoryRequest)(nil),         // 27: MultiWriteMemoryRequest
-	(*MultiWriteMemoryResponse)(nil),        // 28: MultiWriteMemoryResponse
-	(*ReadDirectoryRequest)(nil),            // 29: ReadDirectoryRequest
-	(*DirEntry)(nil),                        // 30: DirEntry
-	(*ReadDirectoryResponse)(nil),           // 31: ReadDirectoryResponse
-	(*MakeDirectoryRequest)(nil),            // 32: MakeDirectoryRequest
-	(*MakeDirectoryResponse)(nil),           // 33: MakeDirectoryResponse
-	(*RemoveFileRequest)(nil),               // 34: RemoveFileRequest
-	(*RemoveFileResponse)(nil),              // 35: RemoveFileResponse
-	(*RenameFileRequest)(nil),               // 36: RenameFileRequest
-	(*RenameFileResponse)(nil),              // 37: RenameFileResponse
-	(*PutFileRequest)(nil),                  // 38: PutFileRequest
-	(*PutFileResponse)(nil),                 // 39: PutFileResponse
-	(*GetFileRequest)(nil),                  // 40: GetFileRequest
-	(*GetFileResponse)(nil),                 // 41: GetFileResponse
-	(*BootFileRequest)(nil),                 // 42: BootFileRequest
-	(*BootFileResponse)(nil),                // 43: BootFileResponse
-	(*FieldsRequest)(nil),                   // 44: FieldsRequest
-	(*FieldsResponse)(nil),                  // 45: FieldsResponse
-	(*NWACommandRequest)(nil),               // 46: NWACommandRequest
-	(*NWACommandResponse)(nil),              // 47: NWACommandResponse
-	(*DevicesResponse_Device)(nil),          // 48: DevicesResponse.Device
-	(*NWACommandResponse_NWAASCIIItem)(nil), // 49: NWACommandResponse.NWAASCIIItem
-	nil,                                     // 50: NWACommandResponse.NWAASCIIItem.ItemEntry
-}
-var file_sni_proto_depIdxs = []int32{
-	48, // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
-	1,  // 1: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
-	1,  // 2: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
-	0,  // 3: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 4: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 5: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 6: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 7: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	0,  // 8: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
-	1,  // 9: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 10: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
-	1,  // 11: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
-	0,  // 12: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
-	17, // 13: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
-	18, // 14: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
-	19, // 15: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
-	20, // 16: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
-	17, // 17: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
-	18, // 18: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
-	19, // 19: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
-	20, // 20: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
-	4,  // 21: DirEntry.type:type_name -> DirEntryType
-	30, // 22: ReadDirectoryResponse.entries:type_name -> DirEntry
-	3,  // 23: FieldsRequest.fields:type_name -> Field
-	3,  // 24: FieldsResponse.fields:type_name -> Field
-	49, // 25: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
-	2,  // 26: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
-	0,  // 27: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
-	50, // 28: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
-	5,  // 29: Devices.ListDevices:input_type -> DevicesRequest
-	7,  // 30: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
-	9,  // 31: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
-	11, // 32: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
-	13, // 33: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
-	15, // 34: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
-	21, // 35: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
-	23, // 36: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
-	25, // 37: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
-	27, // 38: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
-	25, // 39: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
-	27, // 40: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
-	29, // 41: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
-	32, // 42: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
-	34, // 43: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
-	36, // 44: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
-	38, // 45: DeviceFilesystem.PutFile:input_type -> PutFileRequest
-	40, // 46: DeviceFilesystem.GetFile:input_type -> GetFileRequest
-	42, // 47: DeviceFilesystem.BootFile:input_type -> BootFileRequest
-	44, // 48: DeviceInfo.FetchFields:input_type -> FieldsRequest
-	46, // 49: DeviceNWA.NWACommand:input_type -> NWACommandRequest
-	6,  // 50: Devices.ListDevices:output_type -> DevicesResponse
-	8,  // 51: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
-	10, // 52: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
-	12, // 53: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
-	14, // 54: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
-	16, // 55: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
-	22, // 56: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
-	24, // 57: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
-	26, // 58: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
-	28, // 59: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
-	26, // 60: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
-	28, // 61: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
-	31, // 62: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
-	33, // 63: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
-	35, // 64: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
-	37, // 65: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
-	39, // 66: DeviceFilesystem.PutFile:output_type -> PutFileResponse
-	41, // 67: DeviceFilesystem.GetFile:output_type -> GetFileResponse
-	43, // 68: DeviceFilesystem.BootFile:output_type -> BootFileResponse
-	45, // 69: DeviceInfo.FetchFields:output_type -> FieldsResponse
-	47, // 70: DeviceNWA.NWACommand:output_type -> NWACommandResponse
-	50, // [50:71] is the sub-list for method output_type
-	29, // [29:50] is the sub-list for method input_type
-	29, // [29:29] is the sub-list for extension type_name
-	29, // [29:29] is the sub-list for extension extendee
-	0,  // [0:29] is the sub-list for field type_name
-}
-
-func init() { file_sni_proto_init() }
-func file_sni_proto_init() {
-	if File_sni_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesRequest); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFileResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RemoveFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RenameFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileRequest) Reset() {
+	*x = RenameFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileRequest) ProtoMessage() {}
+
+func (x *RenameFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileRequest.ProtoReflect.Descriptor instead.
+func (*RenameFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *RenameFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileRequest) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type RenameFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri         string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path        string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	NewFilename string `protobuf:"bytes,3,opt,name=newFilename,proto3" json:"newFilename,omitempty"`
+}
+
+func (x *RenameFileResponse) Reset() {
+	*x = RenameFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameFileResponse) ProtoMessage() {}
+
+func (x *RenameFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameFileResponse.ProtoReflect.Descriptor instead.
+func (*RenameFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RenameFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RenameFileResponse) GetNewFilename() string {
+	if x != nil {
+		return x.NewFilename
+	}
+	return ""
+}
+
+type PutFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// read the file back after uploading and compare its hash with the uploaded data:
+	Verify bool `protobuf:"varint,4,opt,name=verify,proto3" json:"verify,omitempty"`
+	// upload to a temporary file next to `path` and only replace `path` with it once the upload (and verification)
+	// succeeded:
+	Atomic bool `protobuf:"varint,5,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// resumable upload: `data` is staged in SNI at `offset` (defaults to the end of the already staged data) until
+	// `totalSize` bytes have arrived, and only then is the complete file uploaded to the device. After an interrupted
+	// transfer, send a request with no `data` to find out how many bytes were `received` and continue from there:
+	Resume    bool    `protobuf:"varint,6,opt,name=resume,proto3" json:"resume,omitempty"`
+	Offset    *uint32 `protobuf:"varint,7,opt,name=offset,proto3,oneof" json:"offset,omitempty"`
+	TotalSize uint32  `protobuf:"varint,8,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+}
+
+func (x *PutFileRequest) Reset() {
+	*x = PutFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileRequest) ProtoMessage() {}
+
+func (x *PutFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileRequest.ProtoReflect.Descriptor instead.
+func (*PutFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PutFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PutFileRequest) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+func (x *PutFileRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *PutFileRequest) GetResume() bool {
+	if x != nil {
+		return x.Resume
+	}
+	return false
+}
+
+func (x *PutFileRequest) GetOffset() uint32 {
+	if x != nil && x.Offset != nil {
+		return *x.Offset
+	}
+	return 0
+}
+
+func (x *PutFileRequest) GetTotalSize() uint32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type PutFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// number of bytes written to the device; 0 while a resumable upload is still incomplete:
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// number of bytes staged so far for a resumable upload:
+	Received uint32 `protobuf:"varint,4,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (x *PutFileResponse) Reset() {
+	*x = PutFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutFileResponse) ProtoMessage() {}
+
+func (x *PutFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutFileResponse.ProtoReflect.Descriptor instead.
+func (*PutFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *PutFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *PutFileResponse) GetReceived() uint32 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+type GetFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetFileRequest) Reset() {
+	*x = GetFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileRequest) ProtoMessage() {}
+
+func (x *GetFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileRequest.ProtoReflect.Descriptor instead.
+func (*GetFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetFileResponse) Reset() {
+	*x = GetFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileResponse) ProtoMessage() {}
+
+func (x *GetFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileResponse.ProtoReflect.Descriptor instead.
+func (*GetFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetFileResponse) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *GetFileResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// a file or directory visited by a recursive filesystem operation:
+type TreeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// full path of the entry on the device:
+	Path string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Type DirEntryType `protobuf:"varint,2,opt,name=type,proto3,enum=DirEntryType" json:"type,omitempty"`
+	// size of a file in bytes, if known:
+	Size uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// the error that occurred processing this entry, if any; other entries are still processed:
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TreeEntry) Reset() {
+	*x = TreeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeEntry) ProtoMessage() {}
+
+func (x *TreeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeEntry.ProtoReflect.Descriptor instead.
+func (*TreeEntry) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TreeEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TreeEntry) GetType() DirEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return DirEntryType_Directory
+}
+
+func (x *TreeEntry) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *TreeEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// progress of a recursive filesystem operation, sent after every entry:
+type TreeProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entry *TreeEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// number of entries processed so far:
+	Done uint32 `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
+	// total number of entries, or 0 if not known up front:
+	Total uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *TreeProgress) Reset() {
+	*x = TreeProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TreeProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TreeProgress) ProtoMessage() {}
+
+func (x *TreeProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TreeProgress.ProtoReflect.Descriptor instead.
+func (*TreeProgress) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TreeProgress) GetEntry() *TreeEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *TreeProgress) GetDone() uint32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *TreeProgress) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type WalkDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *WalkDirectoryRequest) Reset() {
+	*x = WalkDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkDirectoryRequest) ProtoMessage() {}
+
+func (x *WalkDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*WalkDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WalkDirectoryRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *WalkDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type WalkDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the directory itself followed by all entries below it, depth-first:
+	Entries []*TreeEntry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *WalkDirectoryResponse) Reset() {
+	*x = WalkDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalkDirectoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalkDirectoryResponse) ProtoMessage() {}
+
+func (x *WalkDirectoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalkDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*WalkDirectoryResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WalkDirectoryResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *WalkDirectoryResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *WalkDirectoryResponse) GetEntries() []*TreeEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type RemoveTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *RemoveTreeRequest) Reset() {
+	*x = RemoveTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTreeRequest) ProtoMessage() {}
+
+func (x *RemoveTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTreeRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTreeRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RemoveTreeRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveTreeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type RemoveTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path     string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Progress *TreeProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *RemoveTreeResponse) Reset() {
+	*x = RemoveTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTreeResponse) ProtoMessage() {}
+
+func (x *RemoveTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTreeResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTreeResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RemoveTreeResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *RemoveTreeResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoveTreeResponse) GetProgress() *TreeProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type PutTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// `uri` and `path` are only read from the first request of the stream:
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the next chunk of the tar archive:
+	Archive []byte `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *PutTreeRequest) Reset() {
+	*x = PutTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTreeRequest) ProtoMessage() {}
+
+func (x *PutTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTreeRequest.ProtoReflect.Descriptor instead.
+func (*PutTreeRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PutTreeRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutTreeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutTreeRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type PutTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string        `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path     string        `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Progress *TreeProgress `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *PutTreeResponse) Reset() {
+	*x = PutTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutTreeResponse) ProtoMessage() {}
+
+func (x *PutTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutTreeResponse.ProtoReflect.Descriptor instead.
+func (*PutTreeResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PutTreeResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *PutTreeResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PutTreeResponse) GetProgress() *TreeProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type GetTreeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *GetTreeRequest) Reset() {
+	*x = GetTreeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeRequest) ProtoMessage() {}
+
+func (x *GetTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTreeRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetTreeRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetTreeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetTreeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// the next chunk of the tar archive, if any:
+	Archive []byte `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	// progress report, if any:
+	Progress *TreeProgress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
+}
+
+func (x *GetTreeResponse) Reset() {
+	*x = GetTreeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTreeResponse) ProtoMessage() {}
+
+func (x *GetTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTreeResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetTreeResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *GetTreeResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetTreeResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *GetTreeResponse) GetProgress() *TreeProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type BootFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileRequest) Reset() {
+	*x = BootFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileRequest) ProtoMessage() {}
+
+func (x *BootFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileRequest.ProtoReflect.Descriptor instead.
+func (*BootFileRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *BootFileRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BootFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *BootFileResponse) Reset() {
+	*x = BootFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BootFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootFileResponse) ProtoMessage() {}
+
+func (x *BootFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootFileResponse.ProtoReflect.Descriptor instead.
+func (*BootFileResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BootFileResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *BootFileResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type FieldsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string  `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+}
+
+func (x *FieldsRequest) Reset() {
+	*x = FieldsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsRequest) ProtoMessage() {}
+
+func (x *FieldsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsRequest.ProtoReflect.Descriptor instead.
+func (*FieldsRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FieldsRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsRequest) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type FieldsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Fields []Field  `protobuf:"varint,2,rep,packed,name=fields,proto3,enum=Field" json:"fields,omitempty"`
+	Values []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *FieldsResponse) Reset() {
+	*x = FieldsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldsResponse) ProtoMessage() {}
+
+func (x *FieldsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldsResponse.ProtoReflect.Descriptor instead.
+func (*FieldsResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FieldsResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *FieldsResponse) GetFields() []Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *FieldsResponse) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type EmulationStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *EmulationStateRequest) Reset() {
+	*x = EmulationStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmulationStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmulationStateRequest) ProtoMessage() {}
+
+func (x *EmulationStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmulationStateRequest.ProtoReflect.Descriptor instead.
+func (*EmulationStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *EmulationStateRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type EmulationStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri   string         `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	State EmulationState `protobuf:"varint,2,opt,name=state,proto3,enum=EmulationState" json:"state,omitempty"`
+	// file name of the loaded ROM, if known:
+	RomFileName string `protobuf:"bytes,3,opt,name=romFileName,proto3" json:"romFileName,omitempty"`
+	// name of the emulator core running the ROM, if known:
+	CoreName string `protobuf:"bytes,4,opt,name=coreName,proto3" json:"coreName,omitempty"`
+}
+
+func (x *EmulationStateResponse) Reset() {
+	*x = EmulationStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmulationStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmulationStateResponse) ProtoMessage() {}
+
+func (x *EmulationStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmulationStateResponse.ProtoReflect.Descriptor instead.
+func (*EmulationStateResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *EmulationStateResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *EmulationStateResponse) GetState() EmulationState {
+	if x != nil {
+		return x.State
+	}
+	return EmulationState_StateUnknown
+}
+
+func (x *EmulationStateResponse) GetRomFileName() string {
+	if x != nil {
+		return x.RomFileName
+	}
+	return ""
+}
+
+func (x *EmulationStateResponse) GetCoreName() string {
+	if x != nil {
+		return x.CoreName
+	}
+	return ""
+}
+
+type WatchEmulationStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// how often to poll the device in milliseconds; defaults to 1000:
+	IntervalMs uint32 `protobuf:"varint,2,opt,name=intervalMs,proto3" json:"intervalMs,omitempty"`
+}
+
+func (x *WatchEmulationStateRequest) Reset() {
+	*x = WatchEmulationStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEmulationStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEmulationStateRequest) ProtoMessage() {}
+
+func (x *WatchEmulationStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEmulationStateRequest.ProtoReflect.Descriptor instead.
+func (*WatchEmulationStateRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *WatchEmulationStateRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *WatchEmulationStateRequest) GetIntervalMs() uint32 {
+	if x != nil {
+		return x.IntervalMs
+	}
+	return 0
+}
+
+type NWACommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// emu-nwaccess command name:
+	Command string `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	// command arguments:
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// an optional binary argument:
+	BinaryArg []byte `protobuf:"bytes,4,opt,name=binaryArg,proto3,oneof" json:"binaryArg,omitempty"`
+}
+
+func (x *NWACommandRequest) Reset() {
+	*x = NWACommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandRequest) ProtoMessage() {}
+
+func (x *NWACommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandRequest.ProtoReflect.Descriptor instead.
+func (*NWACommandRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *NWACommandRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetArgs() string {
+	if x != nil {
+		return x.Args
+	}
+	return ""
+}
+
+func (x *NWACommandRequest) GetBinaryArg() []byte {
+	if x != nil {
+		return x.BinaryArg
+	}
+	return nil
+}
+
+type NWACommandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri          string                             `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	AsciiReply   []*NWACommandResponse_NWAASCIIItem `protobuf:"bytes,2,rep,name=asciiReply,proto3" json:"asciiReply,omitempty"`
+	BinaryReplay []byte                             `protobuf:"bytes,3,opt,name=binaryReplay,proto3,oneof" json:"binaryReplay,omitempty"`
+}
+
+func (x *NWACommandResponse) Reset() {
+	*x = NWACommandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse) ProtoMessage() {}
+
+func (x *NWACommandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *NWACommandResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACommandResponse) GetAsciiReply() []*NWACommandResponse_NWAASCIIItem {
+	if x != nil {
+		return x.AsciiReply
+	}
+	return nil
+}
+
+func (x *NWACommandResponse) GetBinaryReplay() []byte {
+	if x != nil {
+		return x.BinaryReplay
+	}
+	return nil
+}
+
+type NWACore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// path of the core's library file:
+	File string `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+}
+
+func (x *NWACore) Reset() {
+	*x = NWACore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACore) ProtoMessage() {}
+
+func (x *NWACore) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACore.ProtoReflect.Descriptor instead.
+func (*NWACore) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *NWACore) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NWACore) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *NWACore) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NWACore) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+type NWACoreMemory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// "r", "w" or "rw":
+	Access string `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
+	Size   uint32 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *NWACoreMemory) Reset() {
+	*x = NWACoreMemory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoreMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoreMemory) ProtoMessage() {}
+
+func (x *NWACoreMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoreMemory.ProtoReflect.Descriptor instead.
+func (*NWACoreMemory) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *NWACoreMemory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NWACoreMemory) GetAccess() string {
+	if x != nil {
+		return x.Access
+	}
+	return ""
+}
+
+func (x *NWACoreMemory) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type NWAEmulatorInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *NWAEmulatorInfoRequest) Reset() {
+	*x = NWAEmulatorInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWAEmulatorInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWAEmulatorInfoRequest) ProtoMessage() {}
+
+func (x *NWAEmulatorInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWAEmulatorInfoRequest.ProtoReflect.Descriptor instead.
+func (*NWAEmulatorInfoRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *NWAEmulatorInfoRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type NWAEmulatorInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri        string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version    string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	NwaVersion string `protobuf:"bytes,4,opt,name=nwaVersion,proto3" json:"nwaVersion,omitempty"`
+	Id         string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// commands the emulator supports:
+	Commands []string `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+}
+
+func (x *NWAEmulatorInfoResponse) Reset() {
+	*x = NWAEmulatorInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWAEmulatorInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWAEmulatorInfoResponse) ProtoMessage() {}
+
+func (x *NWAEmulatorInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWAEmulatorInfoResponse.ProtoReflect.Descriptor instead.
+func (*NWAEmulatorInfoResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *NWAEmulatorInfoResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWAEmulatorInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NWAEmulatorInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NWAEmulatorInfoResponse) GetNwaVersion() string {
+	if x != nil {
+		return x.NwaVersion
+	}
+	return ""
+}
+
+func (x *NWAEmulatorInfoResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NWAEmulatorInfoResponse) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+type NWACoresListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// optional platform to list cores for, e.g. "SNES":
+	Platform string `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+}
+
+func (x *NWACoresListRequest) Reset() {
+	*x = NWACoresListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoresListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoresListRequest) ProtoMessage() {}
+
+func (x *NWACoresListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoresListRequest.ProtoReflect.Descriptor instead.
+func (*NWACoresListRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *NWACoresListRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACoresListRequest) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+type NWACoresListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri   string     `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Cores []*NWACore `protobuf:"bytes,2,rep,name=cores,proto3" json:"cores,omitempty"`
+}
+
+func (x *NWACoresListResponse) Reset() {
+	*x = NWACoresListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoresListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoresListResponse) ProtoMessage() {}
+
+func (x *NWACoresListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoresListResponse.ProtoReflect.Descriptor instead.
+func (*NWACoresListResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *NWACoresListResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACoresListResponse) GetCores() []*NWACore {
+	if x != nil {
+		return x.Cores
+	}
+	return nil
+}
+
+type NWACoreInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// core name; the current core if empty:
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NWACoreInfoRequest) Reset() {
+	*x = NWACoreInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoreInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoreInfoRequest) ProtoMessage() {}
+
+func (x *NWACoreInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoreInfoRequest.ProtoReflect.Descriptor instead.
+func (*NWACoreInfoRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *NWACoreInfoRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACoreInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NWACoreInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string   `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Core *NWACore `protobuf:"bytes,2,opt,name=core,proto3" json:"core,omitempty"`
+}
+
+func (x *NWACoreInfoResponse) Reset() {
+	*x = NWACoreInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoreInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoreInfoResponse) ProtoMessage() {}
+
+func (x *NWACoreInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoreInfoResponse.ProtoReflect.Descriptor instead.
+func (*NWACoreInfoResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *NWACoreInfoResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACoreInfoResponse) GetCore() *NWACore {
+	if x != nil {
+		return x.Core
+	}
+	return nil
+}
+
+type NWALoadCoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri  string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *NWALoadCoreRequest) Reset() {
+	*x = NWALoadCoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWALoadCoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWALoadCoreRequest) ProtoMessage() {}
+
+func (x *NWALoadCoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWALoadCoreRequest.ProtoReflect.Descriptor instead.
+func (*NWALoadCoreRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *NWALoadCoreRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWALoadCoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type NWALoadCoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *NWALoadCoreResponse) Reset() {
+	*x = NWALoadCoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWALoadCoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWALoadCoreResponse) ProtoMessage() {}
+
+func (x *NWALoadCoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWALoadCoreResponse.ProtoReflect.Descriptor instead.
+func (*NWALoadCoreResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *NWALoadCoreResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type NWACoreMemoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *NWACoreMemoriesRequest) Reset() {
+	*x = NWACoreMemoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoreMemoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoreMemoriesRequest) ProtoMessage() {}
+
+func (x *NWACoreMemoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoreMemoriesRequest.ProtoReflect.Descriptor instead.
+func (*NWACoreMemoriesRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *NWACoreMemoriesRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type NWACoreMemoriesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri      string           `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Memories []*NWACoreMemory `protobuf:"bytes,2,rep,name=memories,proto3" json:"memories,omitempty"`
+}
+
+func (x *NWACoreMemoriesResponse) Reset() {
+	*x = NWACoreMemoriesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACoreMemoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACoreMemoriesResponse) ProtoMessage() {}
+
+func (x *NWACoreMemoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACoreMemoriesResponse.ProtoReflect.Descriptor instead.
+func (*NWACoreMemoriesResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NWACoreMemoriesResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWACoreMemoriesResponse) GetMemories() []*NWACoreMemory {
+	if x != nil {
+		return x.Memories
+	}
+	return nil
+}
+
+type NWALoadGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// path of the game on the emulator's host:
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *NWALoadGameRequest) Reset() {
+	*x = NWALoadGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWALoadGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWALoadGameRequest) ProtoMessage() {}
+
+func (x *NWALoadGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWALoadGameRequest.ProtoReflect.Descriptor instead.
+func (*NWALoadGameRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *NWALoadGameRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWALoadGameRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type NWALoadGameResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *NWALoadGameResponse) Reset() {
+	*x = NWALoadGameResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWALoadGameResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWALoadGameResponse) ProtoMessage() {}
+
+func (x *NWALoadGameResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWALoadGameResponse.ProtoReflect.Descriptor instead.
+func (*NWALoadGameResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *NWALoadGameResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type NWAGameInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *NWAGameInfoRequest) Reset() {
+	*x = NWAGameInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWAGameInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWAGameInfoRequest) ProtoMessage() {}
+
+func (x *NWAGameInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWAGameInfoRequest.ProtoReflect.Descriptor instead.
+func (*NWAGameInfoRequest) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *NWAGameInfoRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type NWAGameInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uri    string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	File   string `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	Region string `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Type   string `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (x *NWAGameInfoResponse) Reset() {
+	*x = NWAGameInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWAGameInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWAGameInfoResponse) ProtoMessage() {}
+
+func (x *NWAGameInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWAGameInfoResponse.ProtoReflect.Descriptor instead.
+func (*NWAGameInfoResponse) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *NWAGameInfoResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *NWAGameInfoResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NWAGameInfoResponse) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *NWAGameInfoResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NWAGameInfoResponse) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type DevicesResponse_Device struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// URI that describes exactly how to connect to the device, e.g.:
+	// RetroArch:  "ra://127.0.0.1:55355"
+	// FX Pak Pro: "fxpakpro://./dev/cu.usbmodemDEMO000000001" (MacOS)
+	//             "fxpakpro://./COM4"                         (Windows)
+	//             "fxpakpro://./dev/ttyACM0"                  (Linux)
+	//             "tcp://raspberrypi:2000"                    (TCP serial bridge)
+	//             "fxpakpro://id/usb-1-1.2"                   (stable identity, see id)
+	// uri is used as the unique identifier of the device for clients to refer to
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	// friendly display name of the device
+	DisplayName string `protobuf:"bytes,2,opt,name=displayName,proto3" json:"displayName,omitempty"`
+	// device kind, e.g. "fxpakpro", "retroarch", "lua"
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// all device capabilities:
+	Capabilities []DeviceCapability `protobuf:"varint,4,rep,packed,name=capabilities,proto3,enum=DeviceCapability" json:"capabilities,omitempty"`
+	// default address space for the device:
+	DefaultAddressSpace AddressSpace `protobuf:"varint,5,opt,name=defaultAddressSpace,proto3,enum=AddressSpace" json:"defaultAddressSpace,omitempty"`
+	// [DEPRECATED] console system supported, e.g. "snes", "n64"
+	// since devices can support multiple systems, it's better to fetch platform from DeviceInfo.FetchFields method
+	//
+	// Deprecated: Do not use.
+	System string `protobuf:"bytes,6,opt,name=system,proto3" json:"system,omitempty"`
+	// stable identity of the physical device that survives reconnects and reboots, if known, e.g. "sn-<serial>" or
+	// "usb-<location>" for FX Pak Pro devices
+	Id string `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DevicesResponse_Device) Reset() {
+	*x = DevicesResponse_Device{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesResponse_Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesResponse_Device) ProtoMessage() {}
+
+func (x *DevicesResponse_Device) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesResponse_Device.ProtoReflect.Descriptor instead.
+func (*DevicesResponse_Device) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *DevicesResponse_Device) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetCapabilities() []DeviceCapability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *DevicesResponse_Device) GetDefaultAddressSpace() AddressSpace {
+	if x != nil {
+		return x.DefaultAddressSpace
+	}
+	return AddressSpace_FxPakPro
+}
+
+// Deprecated: Do not use.
+func (x *DevicesResponse_Device) GetSystem() string {
+	if x != nil {
+		return x.System
+	}
+	return ""
+}
+
+func (x *DevicesResponse_Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BenchmarkDeviceResponse_Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaudRate       uint32  `protobuf:"varint,1,opt,name=baudRate,proto3" json:"baudRate,omitempty"`
+	BytesPerSecond float64 `protobuf:"fixed64,2,opt,name=bytesPerSecond,proto3" json:"bytesPerSecond,omitempty"`
+	// number of completed read requests and the number of those that returned bad data
+	Transfers uint32 `protobuf:"varint,3,opt,name=transfers,proto3" json:"transfers,omitempty"`
+	Errors    uint32 `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	// set if the device could not be opened or failed at this rate
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BenchmarkDeviceResponse_Result) Reset() {
+	*x = BenchmarkDeviceResponse_Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BenchmarkDeviceResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BenchmarkDeviceResponse_Result) ProtoMessage() {}
+
+func (x *BenchmarkDeviceResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BenchmarkDeviceResponse_Result.ProtoReflect.Descriptor instead.
+func (*BenchmarkDeviceResponse_Result) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *BenchmarkDeviceResponse_Result) GetBaudRate() uint32 {
+	if x != nil {
+		return x.BaudRate
+	}
+	return 0
+}
+
+func (x *BenchmarkDeviceResponse_Result) GetBytesPerSecond() float64 {
+	if x != nil {
+		return x.BytesPerSecond
+	}
+	return 0
+}
+
+func (x *BenchmarkDeviceResponse_Result) GetTransfers() uint32 {
+	if x != nil {
+		return x.Transfers
+	}
+	return 0
+}
+
+func (x *BenchmarkDeviceResponse_Result) GetErrors() uint32 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *BenchmarkDeviceResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type NWACommandResponse_NWAASCIIItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item map[string]string `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) Reset() {
+	*x = NWACommandResponse_NWAASCIIItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_sni_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NWACommandResponse_NWAASCIIItem) ProtoMessage() {}
+
+func (x *NWACommandResponse_NWAASCIIItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sni_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NWACommandResponse_NWAASCIIItem.ProtoReflect.Descriptor instead.
+func (*NWACommandResponse_NWAASCIIItem) Descriptor() ([]byte, []int) {
+	return file_sni_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *NWACommandResponse_NWAASCIIItem) GetItem() map[string]string {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+var File_sni_proto protoreflect.FileDescriptor
+
+var file_sni_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x73, 0x6e, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x22, 0xbb, 0x02, 0x0a, 0x0f, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x52, 0x07, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x1a, 0xf4, 0x01, 0x0a, 0x06, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c,
+	0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x35, 0x0a,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x52, 0x13, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x68, 0x0a, 0x16, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x61, 0x75, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x09, 0x62, 0x61, 0x75, 0x64, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x17,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x65, 0x73, 0x74, 0x42, 0x61, 0x75, 0x64,
+	0x52, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x65, 0x73, 0x74,
+	0x42, 0x61, 0x75, 0x64, 0x52, 0x61, 0x74, 0x65, 0x1a, 0x98, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x61, 0x75, 0x64, 0x52, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x61, 0x75, 0x64, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x22, 0x26, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d,
+	0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x27, 0x0a, 0x13,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x41, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x42, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x1b,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x30, 0x0a,
+	0x1c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x6d, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x3e,
+	0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x68,
+	0x0a, 0x10, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x62, 0x0a, 0x10, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x25, 0x0a, 0x11, 0x4c, 0x6f, 0x61,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x25, 0x0a, 0x11, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x6c, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x65, 0x65,
+	0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3f, 0x0a, 0x13, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x66, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x14, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41,
+	0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x22, 0x42, 0x0a, 0x18, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x22, 0x2d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x22, 0x6c, 0x0a, 0x0e, 0x42, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x37, 0x0a, 0x0f, 0x42, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xd6, 0x01, 0x0a, 0x1a, 0x44,
+	0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x49, 0x0a, 0x15, 0x66,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x15, 0x66, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48,
+	0x01, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46,
+	0x42, 0x30, 0x88, 0x01, 0x01, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46,
+	0x46, 0x42, 0x30, 0x22, 0xaf, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x34, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x72,
+	0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30, 0x30, 0x46, 0x46, 0x42, 0x30, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x6f, 0x6d, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x30,
+	0x30, 0x46, 0x46, 0x42, 0x30, 0x22, 0x2c, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x0c, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x72, 0x69, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x22, 0x56, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xd4, 0x01, 0x0a, 0x11, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x22, 0xba, 0x02, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e,
+	0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd5, 0x01,
+	0x0a, 0x12, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a,
+	0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61,
+	0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xdd, 0x02, 0x0a, 0x13, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x52, 0x13, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x42, 0x0a, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x14, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x3d, 0x0a, 0x12, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x12, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x17, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x2c, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x5d, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2f,
+	0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x5b, 0x0a, 0x18, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2d, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x19,
+	0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a,
+	0x16, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x22, 0x5e, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x74, 0x0a, 0x17, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x2f, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x60, 0x0a,
+	0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22,
+	0x3c, 0x0a, 0x14, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41, 0x0a,
+	0x08, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x44, 0x69,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x62, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x23, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x09, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x14, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x22, 0x3d, 0x0a, 0x15, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x39, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x3a, 0x0a, 0x12,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x5b, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x5c, 0x0a, 0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x46, 0x69, 0x6c, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0xd8, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x69, 0x7a, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x67,
+	0x0a, 0x0f, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22,
+	0x5f, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x6c, 0x0a, 0x09, 0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0d, 0x2e, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x5a,
+	0x0a, 0x0c, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20,
+	0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e,
+	0x54, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x64, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x3c, 0x0a, 0x14, 0x57, 0x61,
+	0x6c, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x63, 0x0a, 0x15, 0x57, 0x61, 0x6c, 0x6b,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x24, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x39, 0x0a,
+	0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x65, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x50, 0x0a, 0x0e, 0x50, 0x75, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x22, 0x62, 0x0a, 0x0f, 0x50, 0x75, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54,
+	0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x7c, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x12, 0x29, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x54, 0x72, 0x65, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x37, 0x0a, 0x0f, 0x42,
+	0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x22, 0x38, 0x0a, 0x10, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x41,
+	0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x22, 0x5a, 0x0a, 0x0e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x29, 0x0a,
+	0x15, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x45, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x25, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x63, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4e, 0x0a, 0x1a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x11, 0x4e,
+	0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x12, 0x21, 0x0a, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x09, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72, 0x67,
+	0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x72,
+	0x67, 0x22, 0xac, 0x02, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x40, 0x0a, 0x0a, 0x61, 0x73,
+	0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43, 0x49, 0x49, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x0a, 0x61, 0x73, 0x63, 0x69, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0c,
+	0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x87, 0x01, 0x0a, 0x0c, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43,
+	0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3e, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x57, 0x41, 0x41, 0x53, 0x43,
+	0x49, 0x49, 0x49, 0x74, 0x65, 0x6d, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x22, 0x67, 0x0a, 0x07, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x4f, 0x0a, 0x0d, 0x4e, 0x57, 0x41,
+	0x43, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x2a, 0x0a, 0x16, 0x4e, 0x57,
+	0x41, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xa5, 0x01, 0x0a, 0x17, 0x4e, 0x57, 0x41, 0x45, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x77, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x77, 0x61, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x43,
+	0x0a, 0x13, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x22, 0x48, 0x0a, 0x14, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1e, 0x0a,
+	0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x4e,
+	0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0x3a, 0x0a,
+	0x12, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x45, 0x0a, 0x13, 0x4e, 0x57, 0x41,
+	0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x1c, 0x0a, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x04, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x3a, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x27, 0x0a, 0x13,
+	0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x2a, 0x0a, 0x16, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x69, 0x22, 0x57, 0x0a, 0x17, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x2a,
+	0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x4e, 0x57,
+	0x41, 0x4c, 0x6f, 0x61, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x27, 0x0a, 0x13, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61,
+	0x64, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22,
+	0x26, 0x0a, 0x12, 0x4e, 0x57, 0x41, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x7b, 0x0a, 0x13, 0x4e, 0x57, 0x41, 0x47, 0x61,
+	0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x2a, 0x58, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x78, 0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x6e, 0x65, 0x73, 0x41, 0x42, 0x75, 0x73, 0x10, 0x01,
+	0x12, 0x07, 0x0a, 0x03, 0x52, 0x61, 0x77, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x78, 0x50,
+	0x61, 0x6b, 0x50, 0x72, 0x6f, 0x4d, 0x53, 0x55, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x78,
+	0x50, 0x61, 0x6b, 0x50, 0x72, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x04, 0x2a, 0x48,
+	0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05,
+	0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4c, 0x6f, 0x52, 0x4f, 0x4d,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x78, 0x48, 0x69, 0x52, 0x4f, 0x4d, 0x10, 0x03, 0x12,
+	0x07, 0x0a, 0x03, 0x53, 0x41, 0x31, 0x10, 0x04, 0x2a, 0xb6, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x06, 0x12, 0x0f,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x10, 0x07, 0x12,
+	0x0f, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x10, 0x08,
+	0x12, 0x11, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x10, 0x0b, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x10, 0x0c, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x10, 0x0d, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c,
+	0x65, 0x10, 0x0e, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x0f,
+	0x12, 0x0c, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x10, 0x12, 0x0e,
+	0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x10, 0x14, 0x12, 0x0d,
+	0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x1e, 0x12, 0x0e, 0x0a,
+	0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x10, 0x1f, 0x12, 0x10, 0x0a,
+	0x0c, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x10, 0x20, 0x12,
+	0x15, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x10, 0x21, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x10, 0x22, 0x12, 0x0b, 0x0a,
+	0x07, 0x42, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6d, 0x10, 0x23, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x10,
+	0x24, 0x2a, 0xa2, 0x02, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x01, 0x12, 0x10,
+	0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x02,
+	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x43, 0x6f, 0x72, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x14, 0x12, 0x0f, 0x0a,
+	0x0b, 0x43, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x10, 0x16,
+	0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10,
+	0x28, 0x12, 0x0f, 0x0a, 0x0b, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x54, 0x79, 0x70, 0x65,
+	0x10, 0x29, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6d, 0x48, 0x61, 0x73, 0x68, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x10, 0x2a, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x6f, 0x6d, 0x43, 0x68, 0x69, 0x70, 0x73,
+	0x10, 0x2b, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x61, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x3c, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x61, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10,
+	0x3d, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x10, 0x3e, 0x2a, 0x56, 0x0a, 0x0e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x6f,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x49, 0x6e, 0x4d,
+	0x65, 0x6e, 0x75, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x10, 0x04, 0x2a, 0x27,
+	0x0a, 0x0c, 0x44, 0x69, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0d,
+	0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x10, 0x00, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x69, 0x6c, 0x65, 0x10, 0x01, 0x32, 0x85, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x0f, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x42, 0x65, 0x6e, 0x63, 0x68,
+	0x6d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x17, 0x2e, 0x42, 0x65, 0x6e,
+	0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xc5, 0x05, 0x0a, 0x0d, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x12, 0x3a, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x12, 0x13, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x13, 0x2e, 0x52,
+	0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x4d, 0x65, 0x6e, 0x75, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x14, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f,
+	0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x12, 0x12, 0x2e, 0x45, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x53, 0x4d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x11, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x4c,
+	0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x6f,
+	0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x37, 0x0a, 0x0a, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x12,
+	0x12, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x53, 0x63, 0x72, 0x65, 0x65, 0x6e, 0x73, 0x68, 0x6f, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x46, 0x72,
+	0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x2e, 0x46, 0x72, 0x61,
+	0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x41, 0x64, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x53, 0x65, 0x74,
+	0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x19,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x53, 0x65, 0x74, 0x45,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x74, 0x52,
+	0x6f, 0x6d, 0x12, 0x0f, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x52, 0x6f, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xcf, 0x04, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x4c, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x44, 0x65, 0x74, 0x65,
+	0x63, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x18, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x19, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x40, 0x0a, 0x09, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52,
+	0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x12, 0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65,
+	0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12,
+	0x48, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x57, 0x72, 0x69, 0x74, 0x65, 0x12, 0x18,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x57, 0x72, 0x69, 0x74, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x32, 0xfe, 0x04, 0x0a, 0x10, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x40,
+	0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x15, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0d, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x15, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0a, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6e, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e,
+	0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x0f, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x50, 0x75, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x0f, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x12, 0x10, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x57, 0x61, 0x6c, 0x6b, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x57, 0x61, 0x6c, 0x6b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x54, 0x72, 0x65, 0x65, 0x12, 0x12, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x32, 0x0a, 0x07, 0x50, 0x75, 0x74, 0x54, 0x72, 0x65, 0x65, 0x12,
+	0x0f, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x50, 0x75, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x30, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x65, 0x65, 0x12, 0x0f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xd7, 0x01, 0x0a, 0x0a, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x30, 0x0a, 0x0b, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x0e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x16, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x32, 0x83, 0x04, 0x0a, 0x09, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4e,
+	0x57, 0x41, 0x12, 0x37, 0x0a, 0x0a, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x12, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4e,
+	0x57, 0x41, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17,
+	0x2e, 0x4e, 0x57, 0x41, 0x45, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4e, 0x57, 0x41, 0x45, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x14, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x4e, 0x57, 0x41, 0x43,
+	0x6f, 0x72, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0b, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x12, 0x13, 0x2e,
+	0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4e, 0x57,
+	0x41, 0x43, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e,
+	0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4e, 0x57, 0x41, 0x43, 0x6f, 0x72, 0x65,
+	0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x47, 0x61, 0x6d,
+	0x65, 0x12, 0x13, 0x2e, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4e, 0x57, 0x41, 0x4c, 0x6f, 0x61, 0x64,
+	0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3a,
+	0x0a, 0x0b, 0x4e, 0x57, 0x41, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x13, 0x2e,
+	0x4e, 0x57, 0x41, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x4e, 0x57, 0x41, 0x47, 0x61, 0x6d, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x3f, 0x0a, 0x15, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2e,
+	0x73, 0x6e, 0x69, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x61, 0x6c, 0x74, 0x74, 0x70, 0x6f, 0x2f, 0x73, 0x6e, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x73, 0x6e, 0x69, 0xaa, 0x02, 0x03, 0x53, 0x4e, 0x49, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_sni_proto_rawDescOnce sync.Once
+	file_sni_proto_rawDescData = file_sni_proto_rawDesc
+)
+
+func file_sni_proto_rawDescGZIP() []byte {
+	file_sni_proto_rawDescOnce.Do(func() {
+		file_sni_proto_rawDescData = protoimpl.X.CompressGZIP(file_sni_proto_rawDescData)
+	})
+	return file_sni_proto_rawDescData
+}
+
+var file_sni_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_sni_proto_msgTypes = make([]protoimpl.MessageInfo, 95)
+var file_sni_proto_goTypes = []interface{}{
+	(AddressSpace)(0),                       // 0: AddressSpace
+	(MemoryMapping)(0),                      // 1: MemoryMapping
+	(DeviceCapability)(0),                   // 2: DeviceCapability
+	(Field)(0),                              // 3: Field
+	(EmulationState)(0),                     // 4: EmulationState
+	(DirEntryType)(0),                       // 5: DirEntryType
+	(*DevicesRequest)(nil),                  // 6: DevicesRequest
+	(*DevicesResponse)(nil),                 // 7: DevicesResponse
+	(*BenchmarkDeviceRequest)(nil),          // 8: BenchmarkDeviceRequest
+	(*BenchmarkDeviceResponse)(nil),         // 9: BenchmarkDeviceResponse
+	(*ResetSystemRequest)(nil),              // 10: ResetSystemRequest
+	(*ResetSystemResponse)(nil),             // 11: ResetSystemResponse
+	(*ResetToMenuRequest)(nil),              // 12: ResetToMenuRequest
+	(*ResetToMenuResponse)(nil),             // 13: ResetToMenuResponse
+	(*PauseEmulationRequest)(nil),           // 14: PauseEmulationRequest
+	(*PauseEmulationResponse)(nil),          // 15: PauseEmulationResponse
+	(*PauseToggleEmulationRequest)(nil),     // 16: PauseToggleEmulationRequest
+	(*PauseToggleEmulationResponse)(nil),    // 17: PauseToggleEmulationResponse
+	(*ExecuteASMRequest)(nil),               // 18: ExecuteASMRequest
+	(*ExecuteASMResponse)(nil),              // 19: ExecuteASMResponse
+	(*SaveStateRequest)(nil),                // 20: SaveStateRequest
+	(*SaveStateResponse)(nil),               // 21: SaveStateResponse
+	(*LoadStateRequest)(nil),                // 22: LoadStateRequest
+	(*LoadStateResponse)(nil),               // 23: LoadStateResponse
+	(*ScreenshotRequest)(nil),               // 24: ScreenshotRequest
+	(*ScreenshotResponse)(nil),              // 25: ScreenshotResponse
+	(*FrameAdvanceRequest)(nil),             // 26: FrameAdvanceRequest
+	(*FrameAdvanceResponse)(nil),            // 27: FrameAdvanceResponse
+	(*SetEmulationSpeedRequest)(nil),        // 28: SetEmulationSpeedRequest
+	(*SetEmulationSpeedResponse)(nil),       // 29: SetEmulationSpeedResponse
+	(*BootRomRequest)(nil),                  // 30: BootRomRequest
+	(*BootRomResponse)(nil),                 // 31: BootRomResponse
+	(*DetectMemoryMappingRequest)(nil),      // 32: DetectMemoryMappingRequest
+	(*DetectMemoryMappingResponse)(nil),     // 33: DetectMemoryMappingResponse
+	(*ListMemoryRegionsRequest)(nil),        // 34: ListMemoryRegionsRequest
+	(*MemoryRegion)(nil),                    // 35: MemoryRegion
+	(*ListMemoryRegionsResponse)(nil),       // 36: ListMemoryRegionsResponse
+	(*ReadMemoryRequest)(nil),               // 37: ReadMemoryRequest
+	(*ReadMemoryResponse)(nil),              // 38: ReadMemoryResponse
+	(*WriteMemoryRequest)(nil),              // 39: WriteMemoryRequest
+	(*WriteMemoryResponse)(nil),             // 40: WriteMemoryResponse
+	(*SingleReadMemoryRequest)(nil),         // 41: SingleReadMemoryRequest
+	(*SingleReadMemoryResponse)(nil),        // 42: SingleReadMemoryResponse
+	(*SingleWriteMemoryRequest)(nil),        // 43: SingleWriteMemoryRequest
+	(*SingleWriteMemoryResponse)(nil),       // 44: SingleWriteMemoryResponse
+	(*MultiReadMemoryRequest)(nil),          // 45: MultiReadMemoryRequest
+	(*MultiReadMemoryResponse)(nil),         // 46: MultiReadMemoryResponse
+	(*MultiWriteMemoryRequest)(nil),         // 47: MultiWriteMemoryRequest
+	(*MultiWriteMemoryResponse)(nil),        // 48: MultiWriteMemoryResponse
+	(*ReadDirectoryRequest)(nil),            // 49: ReadDirectoryRequest
+	(*DirEntry)(nil),                        // 50: DirEntry
+	(*ReadDirectoryResponse)(nil),           // 51: ReadDirectoryResponse
+	(*MakeDirectoryRequest)(nil),            // 52: MakeDirectoryRequest
+	(*MakeDirectoryResponse)(nil),           // 53: MakeDirectoryResponse
+	(*RemoveFileRequest)(nil),               // 54: RemoveFileRequest
+	(*RemoveFileResponse)(nil),              // 55: RemoveFileResponse
+	(*RenameFileRequest)(nil),               // 56: RenameFileRequest
+	(*RenameFileResponse)(nil),              // 57: RenameFileResponse
+	(*PutFileRequest)(nil),                  // 58: PutFileRequest
+	(*PutFileResponse)(nil),                 // 59: PutFileResponse
+	(*GetFileRequest)(nil),                  // 60: GetFileRequest
+	(*GetFileResponse)(nil),                 // 61: GetFileResponse
+	(*TreeEntry)(nil),                       // 62: TreeEntry
+	(*TreeProgress)(nil),                    // 63: TreeProgress
+	(*WalkDirectoryRequest)(nil),            // 64: WalkDirectoryRequest
+	(*WalkDirectoryResponse)(nil),           // 65: WalkDirectoryResponse
+	(*RemoveTreeRequest)(nil),               // 66: RemoveTreeRequest
+	(*RemoveTreeResponse)(nil),              // 67: RemoveTreeResponse
+	(*PutTreeRequest)(nil),                  // 68: PutTreeRequest
+	(*PutTreeResponse)(nil),                 // 69: PutTreeResponse
+	(*GetTreeRequest)(nil),                  // 70: GetTreeRequest
+	(*GetTreeResponse)(nil),                 // 71: GetTreeResponse
+	(*BootFileRequest)(nil),                 // 72: BootFileRequest
+	(*BootFileResponse)(nil),                // 73: BootFileResponse
+	(*FieldsRequest)(nil),                   // 74: FieldsRequest
+	(*FieldsResponse)(nil),                  // 75: FieldsResponse
+	(*EmulationStateRequest)(nil),           // 76: EmulationStateRequest
+	(*EmulationStateResponse)(nil),          // 77: EmulationStateResponse
+	(*WatchEmulationStateRequest)(nil),      // 78: WatchEmulationStateRequest
+	(*NWACommandRequest)(nil),               // 79: NWACommandRequest
+	(*NWACommandResponse)(nil),              // 80: NWACommandResponse
+	(*NWACore)(nil),                         // 81: NWACore
+	(*NWACoreMemory)(nil),                   // 82: NWACoreMemory
+	(*NWAEmulatorInfoRequest)(nil),          // 83: NWAEmulatorInfoRequest
+	(*NWAEmulatorInfoResponse)(nil),         // 84: NWAEmulatorInfoResponse
+	(*NWACoresListRequest)(nil),             // 85: NWACoresListRequest
+	(*NWACoresListResponse)(nil),            // 86: NWACoresListResponse
+	(*NWACoreInfoRequest)(nil),              // 87: NWACoreInfoRequest
+	(*NWACoreInfoResponse)(nil),             // 88: NWACoreInfoResponse
+	(*NWALoadCoreRequest)(nil),              // 89: NWALoadCoreRequest
+	(*NWALoadCoreResponse)(nil),             // 90: NWALoadCoreResponse
+	(*NWACoreMemoriesRequest)(nil),          // 91: NWACoreMemoriesRequest
+	(*NWACoreMemoriesResponse)(nil),         // 92: NWACoreMemoriesResponse
+	(*NWALoadGameRequest)(nil),              // 93: NWALoadGameRequest
+	(*NWALoadGameResponse)(nil),             // 94: NWALoadGameResponse
+	(*NWAGameInfoRequest)(nil),              // 95: NWAGameInfoRequest
+	(*NWAGameInfoResponse)(nil),             // 96: NWAGameInfoResponse
+	(*DevicesResponse_Device)(nil),          // 97: DevicesResponse.Device
+	(*BenchmarkDeviceResponse_Result)(nil),  // 98: BenchmarkDeviceResponse.Result
+	(*NWACommandResponse_NWAASCIIItem)(nil), // 99: NWACommandResponse.NWAASCIIItem
+	nil,                                     // 100: NWACommandResponse.NWAASCIIItem.ItemEntry
+}
+var file_sni_proto_depIdxs = []int32{
+	97,  // 0: DevicesResponse.devices:type_name -> DevicesResponse.Device
+	98,  // 1: BenchmarkDeviceResponse.results:type_name -> BenchmarkDeviceResponse.Result
+	1,   // 2: DetectMemoryMappingRequest.fallbackMemoryMapping:type_name -> MemoryMapping
+	1,   // 3: DetectMemoryMappingResponse.memoryMapping:type_name -> MemoryMapping
+	0,   // 4: MemoryRegion.addressSpace:type_name -> AddressSpace
+	35,  // 5: ListMemoryRegionsResponse.regions:type_name -> MemoryRegion
+	0,   // 6: ReadMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,   // 7: ReadMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 8: ReadMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,   // 9: ReadMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 10: ReadMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	0,   // 11: WriteMemoryRequest.requestAddressSpace:type_name -> AddressSpace
+	1,   // 12: WriteMemoryRequest.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 13: WriteMemoryResponse.requestAddressSpace:type_name -> AddressSpace
+	1,   // 14: WriteMemoryResponse.requestMemoryMapping:type_name -> MemoryMapping
+	0,   // 15: WriteMemoryResponse.deviceAddressSpace:type_name -> AddressSpace
+	37,  // 16: SingleReadMemoryRequest.request:type_name -> ReadMemoryRequest
+	38,  // 17: SingleReadMemoryResponse.response:type_name -> ReadMemoryResponse
+	39,  // 18: SingleWriteMemoryRequest.request:type_name -> WriteMemoryRequest
+	40,  // 19: SingleWriteMemoryResponse.response:type_name -> WriteMemoryResponse
+	37,  // 20: MultiReadMemoryRequest.requests:type_name -> ReadMemoryRequest
+	38,  // 21: MultiReadMemoryResponse.responses:type_name -> ReadMemoryResponse
+	39,  // 22: MultiWriteMemoryRequest.requests:type_name -> WriteMemoryRequest
+	40,  // 23: MultiWriteMemoryResponse.responses:type_name -> WriteMemoryResponse
+	5,   // 24: DirEntry.type:type_name -> DirEntryType
+	50,  // 25: ReadDirectoryResponse.entries:type_name -> DirEntry
+	5,   // 26: TreeEntry.type:type_name -> DirEntryType
+	62,  // 27: TreeProgress.entry:type_name -> TreeEntry
+	62,  // 28: WalkDirectoryResponse.entries:type_name -> TreeEntry
+	63,  // 29: RemoveTreeResponse.progress:type_name -> TreeProgress
+	63,  // 30: PutTreeResponse.progress:type_name -> TreeProgress
+	63,  // 31: GetTreeResponse.progress:type_name -> TreeProgress
+	3,   // 32: FieldsRequest.fields:type_name -> Field
+	3,   // 33: FieldsResponse.fields:type_name -> Field
+	4,   // 34: EmulationStateResponse.state:type_name -> EmulationState
+	99,  // 35: NWACommandResponse.asciiReply:type_name -> NWACommandResponse.NWAASCIIItem
+	81,  // 36: NWACoresListResponse.cores:type_name -> NWACore
+	81,  // 37: NWACoreInfoResponse.core:type_name -> NWACore
+	82,  // 38: NWACoreMemoriesResponse.memories:type_name -> NWACoreMemory
+	2,   // 39: DevicesResponse.Device.capabilities:type_name -> DeviceCapability
+	0,   // 40: DevicesResponse.Device.defaultAddressSpace:type_name -> AddressSpace
+	100, // 41: NWACommandResponse.NWAASCIIItem.item:type_name -> NWACommandResponse.NWAASCIIItem.ItemEntry
+	6,   // 42: Devices.ListDevices:input_type -> DevicesRequest
+	8,   // 43: Devices.BenchmarkDevice:input_type -> BenchmarkDeviceRequest
+	10,  // 44: DeviceControl.ResetSystem:input_type -> ResetSystemRequest
+	12,  // 45: DeviceControl.ResetToMenu:input_type -> ResetToMenuRequest
+	14,  // 46: DeviceControl.PauseUnpauseEmulation:input_type -> PauseEmulationRequest
+	16,  // 47: DeviceControl.PauseToggleEmulation:input_type -> PauseToggleEmulationRequest
+	18,  // 48: DeviceControl.ExecuteASM:input_type -> ExecuteASMRequest
+	20,  // 49: DeviceControl.SaveState:input_type -> SaveStateRequest
+	22,  // 50: DeviceControl.LoadState:input_type -> LoadStateRequest
+	24,  // 51: DeviceControl.Screenshot:input_type -> ScreenshotRequest
+	26,  // 52: DeviceControl.FrameAdvance:input_type -> FrameAdvanceRequest
+	28,  // 53: DeviceControl.SetEmulationSpeed:input_type -> SetEmulationSpeedRequest
+	30,  // 54: DeviceControl.BootRom:input_type -> BootRomRequest
+	32,  // 55: DeviceMemory.MappingDetect:input_type -> DetectMemoryMappingRequest
+	34,  // 56: DeviceMemory.ListMemoryRegions:input_type -> ListMemoryRegionsRequest
+	41,  // 57: DeviceMemory.SingleRead:input_type -> SingleReadMemoryRequest
+	43,  // 58: DeviceMemory.SingleWrite:input_type -> SingleWriteMemoryRequest
+	45,  // 59: DeviceMemory.MultiRead:input_type -> MultiReadMemoryRequest
+	47,  // 60: DeviceMemory.MultiWrite:input_type -> MultiWriteMemoryRequest
+	45,  // 61: DeviceMemory.StreamRead:input_type -> MultiReadMemoryRequest
+	47,  // 62: DeviceMemory.StreamWrite:input_type -> MultiWriteMemoryRequest
+	49,  // 63: DeviceFilesystem.ReadDirectory:input_type -> ReadDirectoryRequest
+	52,  // 64: DeviceFilesystem.MakeDirectory:input_type -> MakeDirectoryRequest
+	54,  // 65: DeviceFilesystem.RemoveFile:input_type -> RemoveFileRequest
+	56,  // 66: DeviceFilesystem.RenameFile:input_type -> RenameFileRequest
+	58,  // 67: DeviceFilesystem.PutFile:input_type -> PutFileRequest
+	60,  // 68: DeviceFilesystem.GetFile:input_type -> GetFileRequest
+	72,  // 69: DeviceFilesystem.BootFile:input_type -> BootFileRequest
+	64,  // 70: DeviceFilesystem.WalkDirectory:input_type -> WalkDirectoryRequest
+	66,  // 71: DeviceFilesystem.RemoveTree:input_type -> RemoveTreeRequest
+	68,  // 72: DeviceFilesystem.PutTree:input_type -> PutTreeRequest
+	70,  // 73: DeviceFilesystem.GetTree:input_type -> GetTreeRequest
+	74,  // 74: DeviceInfo.FetchFields:input_type -> FieldsRequest
+	76,  // 75: DeviceInfo.GetEmulationState:input_type -> EmulationStateRequest
+	78,  // 76: DeviceInfo.WatchEmulationState:input_type -> WatchEmulationStateRequest
+	79,  // 77: DeviceNWA.NWACommand:input_type -> NWACommandRequest
+	83,  // 78: DeviceNWA.NWAEmulatorInfo:input_type -> NWAEmulatorInfoRequest
+	85,  // 79: DeviceNWA.NWACoresList:input_type -> NWACoresListRequest
+	87,  // 80: DeviceNWA.NWACoreInfo:input_type -> NWACoreInfoRequest
+	89,  // 81: DeviceNWA.NWALoadCore:input_type -> NWALoadCoreRequest
+	91,  // 82: DeviceNWA.NWACoreMemories:input_type -> NWACoreMemoriesRequest
+	93,  // 83: DeviceNWA.NWALoadGame:input_type -> NWALoadGameRequest
+	95,  // 84: DeviceNWA.NWAGameInfo:input_type -> NWAGameInfoRequest
+	7,   // 85: Devices.ListDevices:output_type -> DevicesResponse
+	9,   // 86: Devices.BenchmarkDevice:output_type -> BenchmarkDeviceResponse
+	11,  // 87: DeviceControl.ResetSystem:output_type -> ResetSystemResponse
+	13,  // 88: DeviceControl.ResetToMenu:output_type -> ResetToMenuResponse
+	15,  // 89: DeviceControl.PauseUnpauseEmulation:output_type -> PauseEmulationResponse
+	17,  // 90: DeviceControl.PauseToggleEmulation:output_type -> PauseToggleEmulationResponse
+	19,  // 91: DeviceControl.ExecuteASM:output_type -> ExecuteASMResponse
+	21,  // 92: DeviceControl.SaveState:output_type -> SaveStateResponse
+	23,  // 93: DeviceControl.LoadState:output_type -> LoadStateResponse
+	25,  // 94: DeviceControl.Screenshot:output_type -> ScreenshotResponse
+	27,  // 95: DeviceControl.FrameAdvance:output_type -> FrameAdvanceResponse
+	29,  // 96: DeviceControl.SetEmulationSpeed:output_type -> SetEmulationSpeedResponse
+	31,  // 97: DeviceControl.BootRom:output_type -> BootRomResponse
+	33,  // 98: DeviceMemory.MappingDetect:output_type -> DetectMemoryMappingResponse
+	36,  // 99: DeviceMemory.ListMemoryRegions:output_type -> ListMemoryRegionsResponse
+	42,  // 100: DeviceMemory.SingleRead:output_type -> SingleReadMemoryResponse
+	44,  // 101: DeviceMemory.SingleWrite:output_type -> SingleWriteMemoryResponse
+	46,  // 102: DeviceMemory.MultiRead:output_type -> MultiReadMemoryResponse
+	48,  // 103: DeviceMemory.MultiWrite:output_type -> MultiWriteMemoryResponse
+	46,  // 104: DeviceMemory.StreamRead:output_type -> MultiReadMemoryResponse
+	48,  // 105: DeviceMemory.StreamWrite:output_type -> MultiWriteMemoryResponse
+	51,  // 106: DeviceFilesystem.ReadDirectory:output_type -> ReadDirectoryResponse
+	53,  // 107: DeviceFilesystem.MakeDirectory:output_type -> MakeDirectoryResponse
+	55,  // 108: DeviceFilesystem.RemoveFile:output_type -> RemoveFileResponse
+	57,  // 109: DeviceFilesystem.RenameFile:output_type -> RenameFileResponse
+	59,  // 110: DeviceFilesystem.PutFile:output_type -> PutFileResponse
+	61,  // 111: DeviceFilesystem.GetFile:output_type -> GetFileResponse
+	73,  // 112: DeviceFilesystem.BootFile:output_type -> BootFileResponse
+	65,  // 113: DeviceFilesystem.WalkDirectory:output_type -> WalkDirectoryResponse
+	67,  // 114: DeviceFilesystem.RemoveTree:output_type -> RemoveTreeResponse
+	69,  // 115: DeviceFilesystem.PutTree:output_type -> PutTreeResponse
+	71,  // 116: DeviceFilesystem.GetTree:output_type -> GetTreeResponse
+	75,  // 117: DeviceInfo.FetchFields:output_type -> FieldsResponse
+	77,  // 118: DeviceInfo.GetEmulationState:output_type -> EmulationStateResponse
+	77,  // 119: DeviceInfo.WatchEmulationState:output_type -> EmulationStateResponse
+	80,  // 120: DeviceNWA.NWACommand:output_type -> NWACommandResponse
+	84,  // 121: DeviceNWA.NWAEmulatorInfo:output_type -> NWAEmulatorInfoResponse
+	86,  // 122: DeviceNWA.NWACoresList:output_type -> NWACoresListResponse
+	88,  // 123: DeviceNWA.NWACoreInfo:output_type -> NWACoreInfoResponse
+	90,  // 124: DeviceNWA.NWALoadCore:output_type -> NWALoadCoreResponse
+	92,  // 125: DeviceNWA.NWACoreMemories:output_type -> NWACoreMemoriesResponse
+	94,  // 126: DeviceNWA.NWALoadGame:output_type -> NWALoadGameResponse
+	96,  // 127: DeviceNWA.NWAGameInfo:output_type -> NWAGameInfoResponse
+	85,  // [85:128] is the sub-list for method output_type
+	42,  // [42:85] is the sub-list for method input_type
+	42,  // [42:42] is the sub-list for extension type_name
+	42,  // [42:42] is the sub-list for extension extendee
+	0,   // [0:42] is the sub-list for field type_name
+}
+
+func init() { file_sni_proto_init() }
+func file_sni_proto_init() {
+	if File_sni_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_sni_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkDeviceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkDeviceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetSystemResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetToMenuResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseToggleEmulationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecuteASMResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScreenshotResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameAdvanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrameAdvanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEmulationSpeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetEmulationSpeedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootRomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootRomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DetectMemoryMappingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemoryRegionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoryRegion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListMemoryRegionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiReadMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiWriteMemoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DirEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MakeDirectoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3610,8 +7853,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DevicesResponse); i {
+		file_sni_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3622,8 +7865,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemRequest); i {
+		file_sni_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3634,8 +7877,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetSystemResponse); i {
+		file_sni_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3646,8 +7889,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuRequest); i {
+		file_sni_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3658,8 +7901,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResetToMenuResponse); i {
+		file_sni_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3670,8 +7913,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationRequest); i {
+		file_sni_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3682,8 +7925,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseEmulationResponse); i {
+		file_sni_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3694,8 +7937,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationRequest); i {
+		file_sni_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3706,8 +7949,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauseToggleEmulationResponse); i {
+		file_sni_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3718,8 +7961,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingRequest); i {
+		file_sni_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TreeProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3730,8 +7973,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DetectMemoryMappingResponse); i {
+		file_sni_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkDirectoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3742,8 +7985,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryRequest); i {
+		file_sni_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalkDirectoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3754,8 +7997,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadMemoryResponse); i {
+		file_sni_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3766,8 +8009,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryRequest); i {
+		file_sni_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3778,8 +8021,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WriteMemoryResponse); i {
+		file_sni_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3790,8 +8033,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryRequest); i {
+		file_sni_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3802,8 +8045,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleReadMemoryResponse); i {
+		file_sni_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3814,8 +8057,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTreeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3826,8 +8069,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3838,8 +8081,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryRequest); i {
+		file_sni_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BootFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3850,8 +8093,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiReadMemoryResponse); i {
+		file_sni_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3862,8 +8105,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryRequest); i {
+		file_sni_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3874,8 +8117,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MultiWriteMemoryResponse); i {
+		file_sni_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmulationStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3886,8 +8129,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirectoryRequest); i {
+		file_sni_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmulationStateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3898,8 +8141,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DirEntry); i {
+		file_sni_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEmulationStateRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3910,8 +8153,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadDirectoryResponse); i {
+		file_sni_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3922,8 +8165,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeDirectoryRequest); i {
+		file_sni_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3934,8 +8177,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MakeDirectoryResponse); i {
+		file_sni_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACore); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3946,8 +8189,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveFileRequest); i {
+		file_sni_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoreMemory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3958,8 +8201,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveFileResponse); i {
+		file_sni_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWAEmulatorInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3970,8 +8213,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileRequest); i {
+		file_sni_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWAEmulatorInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3982,8 +8225,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameFileResponse); i {
+		file_sni_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoresListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3994,8 +8237,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileRequest); i {
+		file_sni_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoresListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4006,8 +8249,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PutFileResponse); i {
+		file_sni_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoreInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4018,8 +8261,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileRequest); i {
+		file_sni_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoreInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4030,8 +8273,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetFileResponse); i {
+		file_sni_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWALoadCoreRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4042,8 +8285,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootFileRequest); i {
+		file_sni_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWALoadCoreResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4054,8 +8297,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootFileResponse); i {
+		file_sni_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoreMemoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4066,8 +8309,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsRequest); i {
+		file_sni_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWACoreMemoriesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4078,8 +8321,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FieldsResponse); i {
+		file_sni_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWALoadGameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4090,8 +8333,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NWACommandRequest); i {
+		file_sni_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWALoadGameResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4102,8 +8345,8 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NWACommandResponse); i {
+		file_sni_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWAGameInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4114,7 +8357,19 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NWAGameInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DevicesResponse_Device); i {
 			case 0:
 				return &v.state
@@ -4126,7 +8381,19 @@ func file_sni_proto_init() {
 				return nil
 			}
 		}
-		file_sni_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_sni_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BenchmarkDeviceResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_sni_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NWACommandResponse_NWAASCIIItem); i {
 			case 0:
 				return &v.state
@@ -4139,16 +8406,17 @@ func file_sni_proto_init() {
 			}
 		}
 	}
-	file_sni_proto_msgTypes[10].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[41].OneofWrappers = []interface{}{}
-	file_sni_proto_msgTypes[42].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[52].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[73].OneofWrappers = []interface{}{}
+	file_sni_proto_msgTypes[74].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_sni_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   46,
+			NumEnums:      6,
+			NumMessages:   95,
 			NumExtensions: 0,
 			NumServices:   6,
 		},
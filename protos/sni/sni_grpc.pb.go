@@ -24,6 +24,8 @@ const _ = grpc.SupportPackageIsVersion7
 type DevicesClient interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(ctx context.Context, in *DevicesRequest, opts ...grpc.CallOption) (*DevicesResponse, error)
+	// measure throughput of a serial device at different baud rates and remember the best reliable rate for it:
+	BenchmarkDevice(ctx context.Context, in *BenchmarkDeviceRequest, opts ...grpc.CallOption) (*BenchmarkDeviceResponse, error)
 }
 
 type devicesClient struct {
@@ -43,12 +45,23 @@ func (c *devicesClient) ListDevices(ctx context.Context, in *DevicesRequest, opt
 	return out, nil
 }
 
+func (c *devicesClient) BenchmarkDevice(ctx context.Context, in *BenchmarkDeviceRequest, opts ...grpc.CallOption) (*BenchmarkDeviceResponse, error) {
+	out := new(BenchmarkDeviceResponse)
+	err := c.cc.Invoke(ctx, "/Devices/BenchmarkDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DevicesServer is the server API for Devices service.
 // All implementations must embed UnimplementedDevicesServer
 // for forward compatibility
 type DevicesServer interface {
 	// detect and list devices currently connected to the system:
 	ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error)
+	// measure throughput of a serial device at different baud rates and remember the best reliable rate for it:
+	BenchmarkDevice(context.Context, *BenchmarkDeviceRequest) (*BenchmarkDeviceResponse, error)
 	mustEmbedUnimplementedDevicesServer()
 }
 
@@ -59,6 +72,9 @@ type UnimplementedDevicesServer struct {
 func (UnimplementedDevicesServer) ListDevices(context.Context, *DevicesRequest) (*DevicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
 }
+func (UnimplementedDevicesServer) BenchmarkDevice(context.Context, *BenchmarkDeviceRequest) (*BenchmarkDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkDevice not implemented")
+}
 func (UnimplementedDevicesServer) mustEmbedUnimplementedDevicesServer() {}
 
 // UnsafeDevicesServer may be embedded to opt out of forward compatibility for this service.
@@ -90,6 +106,24 @@ func _Devices_ListDevices_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Devices_BenchmarkDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DevicesServer).BenchmarkDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Devices/BenchmarkDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DevicesServer).BenchmarkDevice(ctx, req.(*BenchmarkDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Devices_ServiceDesc is the grpc.ServiceDesc for Devices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +135,10 @@ var Devices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDevices",
 			Handler:    _Devices_ListDevices_Handler,
 		},
+		{
+			MethodName: "BenchmarkDevice",
+			Handler:    _Devices_BenchmarkDevice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
@@ -118,6 +156,20 @@ type DeviceControlClient interface {
 	PauseUnpauseEmulation(ctx context.Context, in *PauseEmulationRequest, opts ...grpc.CallOption) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(ctx context.Context, in *PauseToggleEmulationRequest, opts ...grpc.CallOption) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability ExecuteASM is present
+	ExecuteASM(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error)
+	// save the emulator state to a slot, a file or return it:
+	SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*SaveStateResponse, error)
+	// load the emulator state from a slot, a file or the request:
+	LoadState(ctx context.Context, in *LoadStateRequest, opts ...grpc.CallOption) (*LoadStateResponse, error)
+	// capture what is currently on screen:
+	Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
+	// advance emulation by a number of frames and leave it paused:
+	FrameAdvance(ctx context.Context, in *FrameAdvanceRequest, opts ...grpc.CallOption) (*FrameAdvanceResponse, error)
+	// fast-forward or slow down emulation:
+	SetEmulationSpeed(ctx context.Context, in *SetEmulationSpeedRequest, opts ...grpc.CallOption) (*SetEmulationSpeedResponse, error)
+	// boot a ROM sent from SNI's host; only available if DeviceCapability BootRom is present:
+	BootRom(ctx context.Context, in *BootRomRequest, opts ...grpc.CallOption) (*BootRomResponse, error)
 }
 
 type deviceControlClient struct {
@@ -164,6 +216,69 @@ func (c *deviceControlClient) PauseToggleEmulation(ctx context.Context, in *Paus
 	return out, nil
 }
 
+func (c *deviceControlClient) ExecuteASM(ctx context.Context, in *ExecuteASMRequest, opts ...grpc.CallOption) (*ExecuteASMResponse, error) {
+	out := new(ExecuteASMResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/ExecuteASM", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) SaveState(ctx context.Context, in *SaveStateRequest, opts ...grpc.CallOption) (*SaveStateResponse, error) {
+	out := new(SaveStateResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/SaveState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) LoadState(ctx context.Context, in *LoadStateRequest, opts ...grpc.CallOption) (*LoadStateResponse, error) {
+	out := new(LoadStateResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/LoadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) Screenshot(ctx context.Context, in *ScreenshotRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error) {
+	out := new(ScreenshotResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/Screenshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) FrameAdvance(ctx context.Context, in *FrameAdvanceRequest, opts ...grpc.CallOption) (*FrameAdvanceResponse, error) {
+	out := new(FrameAdvanceResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/FrameAdvance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) SetEmulationSpeed(ctx context.Context, in *SetEmulationSpeedRequest, opts ...grpc.CallOption) (*SetEmulationSpeedResponse, error) {
+	out := new(SetEmulationSpeedResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/SetEmulationSpeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceControlClient) BootRom(ctx context.Context, in *BootRomRequest, opts ...grpc.CallOption) (*BootRomResponse, error) {
+	out := new(BootRomResponse)
+	err := c.cc.Invoke(ctx, "/DeviceControl/BootRom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceControlServer is the server API for DeviceControl service.
 // All implementations must embed UnimplementedDeviceControlServer
 // for forward compatibility
@@ -176,6 +291,20 @@ type DeviceControlServer interface {
 	PauseUnpauseEmulation(context.Context, *PauseEmulationRequest) (*PauseEmulationResponse, error)
 	// only available if DeviceCapability PauseToggleEmulation is present
 	PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error)
+	// only available if DeviceCapability ExecuteASM is present
+	ExecuteASM(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error)
+	// save the emulator state to a slot, a file or return it:
+	SaveState(context.Context, *SaveStateRequest) (*SaveStateResponse, error)
+	// load the emulator state from a slot, a file or the request:
+	LoadState(context.Context, *LoadStateRequest) (*LoadStateResponse, error)
+	// capture what is currently on screen:
+	Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotResponse, error)
+	// advance emulation by a number of frames and leave it paused:
+	FrameAdvance(context.Context, *FrameAdvanceRequest) (*FrameAdvanceResponse, error)
+	// fast-forward or slow down emulation:
+	SetEmulationSpeed(context.Context, *SetEmulationSpeedRequest) (*SetEmulationSpeedResponse, error)
+	// boot a ROM sent from SNI's host; only available if DeviceCapability BootRom is present:
+	BootRom(context.Context, *BootRomRequest) (*BootRomResponse, error)
 	mustEmbedUnimplementedDeviceControlServer()
 }
 
@@ -195,6 +324,27 @@ func (UnimplementedDeviceControlServer) PauseUnpauseEmulation(context.Context, *
 func (UnimplementedDeviceControlServer) PauseToggleEmulation(context.Context, *PauseToggleEmulationRequest) (*PauseToggleEmulationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PauseToggleEmulation not implemented")
 }
+func (UnimplementedDeviceControlServer) ExecuteASM(context.Context, *ExecuteASMRequest) (*ExecuteASMResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteASM not implemented")
+}
+func (UnimplementedDeviceControlServer) SaveState(context.Context, *SaveStateRequest) (*SaveStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveState not implemented")
+}
+func (UnimplementedDeviceControlServer) LoadState(context.Context, *LoadStateRequest) (*LoadStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadState not implemented")
+}
+func (UnimplementedDeviceControlServer) Screenshot(context.Context, *ScreenshotRequest) (*ScreenshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Screenshot not implemented")
+}
+func (UnimplementedDeviceControlServer) FrameAdvance(context.Context, *FrameAdvanceRequest) (*FrameAdvanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrameAdvance not implemented")
+}
+func (UnimplementedDeviceControlServer) SetEmulationSpeed(context.Context, *SetEmulationSpeedRequest) (*SetEmulationSpeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmulationSpeed not implemented")
+}
+func (UnimplementedDeviceControlServer) BootRom(context.Context, *BootRomRequest) (*BootRomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootRom not implemented")
+}
 func (UnimplementedDeviceControlServer) mustEmbedUnimplementedDeviceControlServer() {}
 
 // UnsafeDeviceControlServer may be embedded to opt out of forward compatibility for this service.
@@ -280,6 +430,132 @@ func _DeviceControl_PauseToggleEmulation_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceControl_ExecuteASM_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteASMRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).ExecuteASM(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/ExecuteASM",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).ExecuteASM(ctx, req.(*ExecuteASMRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_SaveState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).SaveState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/SaveState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).SaveState(ctx, req.(*SaveStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_LoadState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).LoadState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/LoadState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).LoadState(ctx, req.(*LoadStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_Screenshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).Screenshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/Screenshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).Screenshot(ctx, req.(*ScreenshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_FrameAdvance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FrameAdvanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).FrameAdvance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/FrameAdvance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).FrameAdvance(ctx, req.(*FrameAdvanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_SetEmulationSpeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmulationSpeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).SetEmulationSpeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/SetEmulationSpeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).SetEmulationSpeed(ctx, req.(*SetEmulationSpeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceControl_BootRom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootRomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceControlServer).BootRom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceControl/BootRom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceControlServer).BootRom(ctx, req.(*BootRomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceControl_ServiceDesc is the grpc.ServiceDesc for DeviceControl service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -303,6 +579,34 @@ var DeviceControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PauseToggleEmulation",
 			Handler:    _DeviceControl_PauseToggleEmulation_Handler,
 		},
+		{
+			MethodName: "ExecuteASM",
+			Handler:    _DeviceControl_ExecuteASM_Handler,
+		},
+		{
+			MethodName: "SaveState",
+			Handler:    _DeviceControl_SaveState_Handler,
+		},
+		{
+			MethodName: "LoadState",
+			Handler:    _DeviceControl_LoadState_Handler,
+		},
+		{
+			MethodName: "Screenshot",
+			Handler:    _DeviceControl_Screenshot_Handler,
+		},
+		{
+			MethodName: "FrameAdvance",
+			Handler:    _DeviceControl_FrameAdvance_Handler,
+		},
+		{
+			MethodName: "SetEmulationSpeed",
+			Handler:    _DeviceControl_SetEmulationSpeed_Handler,
+		},
+		{
+			MethodName: "BootRom",
+			Handler:    _DeviceControl_BootRom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
@@ -314,6 +618,9 @@ var DeviceControl_ServiceDesc = grpc.ServiceDesc{
 type DeviceMemoryClient interface {
 	// detect the current memory mapping for the given device by reading 00:FFB0 header:
 	MappingDetect(ctx context.Context, in *DetectMemoryMappingRequest, opts ...grpc.CallOption) (*DetectMemoryMappingResponse, error)
+	// list the memory regions the given device can read or write; only available if DeviceCapability
+	// ListMemoryRegions is present:
+	ListMemoryRegions(ctx context.Context, in *ListMemoryRegionsRequest, opts ...grpc.CallOption) (*ListMemoryRegionsResponse, error)
 	// read a single memory segment with a given size from the given device:
 	SingleRead(ctx context.Context, in *SingleReadMemoryRequest, opts ...grpc.CallOption) (*SingleReadMemoryResponse, error)
 	// write a single memory segment with given data to the given device:
@@ -322,7 +629,8 @@ type DeviceMemoryClient interface {
 	MultiRead(ctx context.Context, in *MultiReadMemoryRequest, opts ...grpc.CallOption) (*MultiReadMemoryResponse, error)
 	// write multiple memory segments with given data to the given device:
 	MultiWrite(ctx context.Context, in *MultiWriteMemoryRequest, opts ...grpc.CallOption) (*MultiWriteMemoryResponse, error)
-	// stream read multiple memory segments with given sizes from the given device:
+	// stream read multiple memory segments with given sizes from the given device; requests with `subscribe` set
+	// receive a continuous stream of responses until the next request is sent:
 	StreamRead(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamReadClient, error)
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(ctx context.Context, opts ...grpc.CallOption) (DeviceMemory_StreamWriteClient, error)
@@ -345,6 +653,15 @@ func (c *deviceMemoryClient) MappingDetect(ctx context.Context, in *DetectMemory
 	return out, nil
 }
 
+func (c *deviceMemoryClient) ListMemoryRegions(ctx context.Context, in *ListMemoryRegionsRequest, opts ...grpc.CallOption) (*ListMemoryRegionsResponse, error) {
+	out := new(ListMemoryRegionsResponse)
+	err := c.cc.Invoke(ctx, "/DeviceMemory/ListMemoryRegions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deviceMemoryClient) SingleRead(ctx context.Context, in *SingleReadMemoryRequest, opts ...grpc.CallOption) (*SingleReadMemoryResponse, error) {
 	out := new(SingleReadMemoryResponse)
 	err := c.cc.Invoke(ctx, "/DeviceMemory/SingleRead", in, out, opts...)
@@ -449,6 +766,9 @@ func (x *deviceMemoryStreamWriteClient) Recv() (*MultiWriteMemoryResponse, error
 type DeviceMemoryServer interface {
 	// detect the current memory mapping for the given device by reading 00:FFB0 header:
 	MappingDetect(context.Context, *DetectMemoryMappingRequest) (*DetectMemoryMappingResponse, error)
+	// list the memory regions the given device can read or write; only available if DeviceCapability
+	// ListMemoryRegions is present:
+	ListMemoryRegions(context.Context, *ListMemoryRegionsRequest) (*ListMemoryRegionsResponse, error)
 	// read a single memory segment with a given size from the given device:
 	SingleRead(context.Context, *SingleReadMemoryRequest) (*SingleReadMemoryResponse, error)
 	// write a single memory segment with given data to the given device:
@@ -457,7 +777,8 @@ type DeviceMemoryServer interface {
 	MultiRead(context.Context, *MultiReadMemoryRequest) (*MultiReadMemoryResponse, error)
 	// write multiple memory segments with given data to the given device:
 	MultiWrite(context.Context, *MultiWriteMemoryRequest) (*MultiWriteMemoryResponse, error)
-	// stream read multiple memory segments with given sizes from the given device:
+	// stream read multiple memory segments with given sizes from the given device; requests with `subscribe` set
+	// receive a continuous stream of responses until the next request is sent:
 	StreamRead(DeviceMemory_StreamReadServer) error
 	// stream write multiple memory segments with given data to the given device:
 	StreamWrite(DeviceMemory_StreamWriteServer) error
@@ -471,6 +792,9 @@ type UnimplementedDeviceMemoryServer struct {
 func (UnimplementedDeviceMemoryServer) MappingDetect(context.Context, *DetectMemoryMappingRequest) (*DetectMemoryMappingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MappingDetect not implemented")
 }
+func (UnimplementedDeviceMemoryServer) ListMemoryRegions(context.Context, *ListMemoryRegionsRequest) (*ListMemoryRegionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoryRegions not implemented")
+}
 func (UnimplementedDeviceMemoryServer) SingleRead(context.Context, *SingleReadMemoryRequest) (*SingleReadMemoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SingleRead not implemented")
 }
@@ -520,6 +844,24 @@ func _DeviceMemory_MappingDetect_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceMemory_ListMemoryRegions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoryRegionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceMemoryServer).ListMemoryRegions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceMemory/ListMemoryRegions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceMemoryServer).ListMemoryRegions(ctx, req.(*ListMemoryRegionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DeviceMemory_SingleRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SingleReadMemoryRequest)
 	if err := dec(in); err != nil {
@@ -655,6 +997,10 @@ var DeviceMemory_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MappingDetect",
 			Handler:    _DeviceMemory_MappingDetect_Handler,
 		},
+		{
+			MethodName: "ListMemoryRegions",
+			Handler:    _DeviceMemory_ListMemoryRegions_Handler,
+		},
 		{
 			MethodName: "SingleRead",
 			Handler:    _DeviceMemory_SingleRead_Handler,
@@ -700,6 +1046,14 @@ type DeviceFilesystemClient interface {
 	PutFile(ctx context.Context, in *PutFileRequest, opts ...grpc.CallOption) (*PutFileResponse, error)
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (*GetFileResponse, error)
 	BootFile(ctx context.Context, in *BootFileRequest, opts ...grpc.CallOption) (*BootFileResponse, error)
+	// recursively list all entries below a directory:
+	WalkDirectory(ctx context.Context, in *WalkDirectoryRequest, opts ...grpc.CallOption) (*WalkDirectoryResponse, error)
+	// recursively remove a directory and everything below it, reporting progress per entry:
+	RemoveTree(ctx context.Context, in *RemoveTreeRequest, opts ...grpc.CallOption) (DeviceFilesystem_RemoveTreeClient, error)
+	// extract a tar archive streamed from the client below a directory, reporting progress per entry:
+	PutTree(ctx context.Context, opts ...grpc.CallOption) (DeviceFilesystem_PutTreeClient, error)
+	// stream a directory and everything below it as a tar archive, reporting progress per entry:
+	GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (DeviceFilesystem_GetTreeClient, error)
 }
 
 type deviceFilesystemClient struct {
@@ -773,46 +1127,170 @@ func (c *deviceFilesystemClient) BootFile(ctx context.Context, in *BootFileReque
 	return out, nil
 }
 
-// DeviceFilesystemServer is the server API for DeviceFilesystem service.
-// All implementations must embed UnimplementedDeviceFilesystemServer
-// for forward compatibility
-type DeviceFilesystemServer interface {
-	ReadDirectory(context.Context, *ReadDirectoryRequest) (*ReadDirectoryResponse, error)
-	MakeDirectory(context.Context, *MakeDirectoryRequest) (*MakeDirectoryResponse, error)
-	RemoveFile(context.Context, *RemoveFileRequest) (*RemoveFileResponse, error)
-	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
-	PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error)
-	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
-	BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error)
-	mustEmbedUnimplementedDeviceFilesystemServer()
+func (c *deviceFilesystemClient) WalkDirectory(ctx context.Context, in *WalkDirectoryRequest, opts ...grpc.CallOption) (*WalkDirectoryResponse, error) {
+	out := new(WalkDirectoryResponse)
+	err := c.cc.Invoke(ctx, "/DeviceFilesystem/WalkDirectory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedDeviceFilesystemServer must be embedded to have forward compatible implementations.
-type UnimplementedDeviceFilesystemServer struct {
+func (c *deviceFilesystemClient) RemoveTree(ctx context.Context, in *RemoveTreeRequest, opts ...grpc.CallOption) (DeviceFilesystem_RemoveTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceFilesystem_ServiceDesc.Streams[0], "/DeviceFilesystem/RemoveTree", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceFilesystemRemoveTreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (UnimplementedDeviceFilesystemServer) ReadDirectory(context.Context, *ReadDirectoryRequest) (*ReadDirectoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadDirectory not implemented")
-}
-func (UnimplementedDeviceFilesystemServer) MakeDirectory(context.Context, *MakeDirectoryRequest) (*MakeDirectoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MakeDirectory not implemented")
-}
-func (UnimplementedDeviceFilesystemServer) RemoveFile(context.Context, *RemoveFileRequest) (*RemoveFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveFile not implemented")
+type DeviceFilesystem_RemoveTreeClient interface {
+	Recv() (*RemoveTreeResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedDeviceFilesystemServer) RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+
+type deviceFilesystemRemoveTreeClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedDeviceFilesystemServer) PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PutFile not implemented")
+
+func (x *deviceFilesystemRemoveTreeClient) Recv() (*RemoveTreeResponse, error) {
+	m := new(RemoveTreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedDeviceFilesystemServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+
+func (c *deviceFilesystemClient) PutTree(ctx context.Context, opts ...grpc.CallOption) (DeviceFilesystem_PutTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceFilesystem_ServiceDesc.Streams[1], "/DeviceFilesystem/PutTree", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceFilesystemPutTreeClient{stream}
+	return x, nil
 }
-func (UnimplementedDeviceFilesystemServer) BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BootFile not implemented")
+
+type DeviceFilesystem_PutTreeClient interface {
+	Send(*PutTreeRequest) error
+	Recv() (*PutTreeResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedDeviceFilesystemServer) mustEmbedUnimplementedDeviceFilesystemServer() {}
+
+type deviceFilesystemPutTreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceFilesystemPutTreeClient) Send(m *PutTreeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *deviceFilesystemPutTreeClient) Recv() (*PutTreeResponse, error) {
+	m := new(PutTreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *deviceFilesystemClient) GetTree(ctx context.Context, in *GetTreeRequest, opts ...grpc.CallOption) (DeviceFilesystem_GetTreeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceFilesystem_ServiceDesc.Streams[2], "/DeviceFilesystem/GetTree", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceFilesystemGetTreeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceFilesystem_GetTreeClient interface {
+	Recv() (*GetTreeResponse, error)
+	grpc.ClientStream
+}
+
+type deviceFilesystemGetTreeClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceFilesystemGetTreeClient) Recv() (*GetTreeResponse, error) {
+	m := new(GetTreeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DeviceFilesystemServer is the server API for DeviceFilesystem service.
+// All implementations must embed UnimplementedDeviceFilesystemServer
+// for forward compatibility
+type DeviceFilesystemServer interface {
+	ReadDirectory(context.Context, *ReadDirectoryRequest) (*ReadDirectoryResponse, error)
+	MakeDirectory(context.Context, *MakeDirectoryRequest) (*MakeDirectoryResponse, error)
+	RemoveFile(context.Context, *RemoveFileRequest) (*RemoveFileResponse, error)
+	RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error)
+	PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error)
+	GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error)
+	BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error)
+	// recursively list all entries below a directory:
+	WalkDirectory(context.Context, *WalkDirectoryRequest) (*WalkDirectoryResponse, error)
+	// recursively remove a directory and everything below it, reporting progress per entry:
+	RemoveTree(*RemoveTreeRequest, DeviceFilesystem_RemoveTreeServer) error
+	// extract a tar archive streamed from the client below a directory, reporting progress per entry:
+	PutTree(DeviceFilesystem_PutTreeServer) error
+	// stream a directory and everything below it as a tar archive, reporting progress per entry:
+	GetTree(*GetTreeRequest, DeviceFilesystem_GetTreeServer) error
+	mustEmbedUnimplementedDeviceFilesystemServer()
+}
+
+// UnimplementedDeviceFilesystemServer must be embedded to have forward compatible implementations.
+type UnimplementedDeviceFilesystemServer struct {
+}
+
+func (UnimplementedDeviceFilesystemServer) ReadDirectory(context.Context, *ReadDirectoryRequest) (*ReadDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDirectory not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) MakeDirectory(context.Context, *MakeDirectoryRequest) (*MakeDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MakeDirectory not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) RemoveFile(context.Context, *RemoveFileRequest) (*RemoveFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) RenameFile(context.Context, *RenameFileRequest) (*RenameFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) PutFile(context.Context, *PutFileRequest) (*PutFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) GetFile(context.Context, *GetFileRequest) (*GetFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) BootFile(context.Context, *BootFileRequest) (*BootFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootFile not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) WalkDirectory(context.Context, *WalkDirectoryRequest) (*WalkDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WalkDirectory not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) RemoveTree(*RemoveTreeRequest, DeviceFilesystem_RemoveTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method RemoveTree not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) PutTree(DeviceFilesystem_PutTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method PutTree not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) GetTree(*GetTreeRequest, DeviceFilesystem_GetTreeServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+func (UnimplementedDeviceFilesystemServer) mustEmbedUnimplementedDeviceFilesystemServer() {}
 
 // UnsafeDeviceFilesystemServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to DeviceFilesystemServer will
@@ -951,6 +1429,92 @@ func _DeviceFilesystem_BootFile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceFilesystem_WalkDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WalkDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceFilesystemServer).WalkDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceFilesystem/WalkDirectory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceFilesystemServer).WalkDirectory(ctx, req.(*WalkDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceFilesystem_RemoveTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RemoveTreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceFilesystemServer).RemoveTree(m, &deviceFilesystemRemoveTreeServer{stream})
+}
+
+type DeviceFilesystem_RemoveTreeServer interface {
+	Send(*RemoveTreeResponse) error
+	grpc.ServerStream
+}
+
+type deviceFilesystemRemoveTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceFilesystemRemoveTreeServer) Send(m *RemoveTreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DeviceFilesystem_PutTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DeviceFilesystemServer).PutTree(&deviceFilesystemPutTreeServer{stream})
+}
+
+type DeviceFilesystem_PutTreeServer interface {
+	Send(*PutTreeResponse) error
+	Recv() (*PutTreeRequest, error)
+	grpc.ServerStream
+}
+
+type deviceFilesystemPutTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceFilesystemPutTreeServer) Send(m *PutTreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *deviceFilesystemPutTreeServer) Recv() (*PutTreeRequest, error) {
+	m := new(PutTreeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _DeviceFilesystem_GetTree_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTreeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceFilesystemServer).GetTree(m, &deviceFilesystemGetTreeServer{stream})
+}
+
+type DeviceFilesystem_GetTreeServer interface {
+	Send(*GetTreeResponse) error
+	grpc.ServerStream
+}
+
+type deviceFilesystemGetTreeServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceFilesystemGetTreeServer) Send(m *GetTreeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DeviceFilesystem_ServiceDesc is the grpc.ServiceDesc for DeviceFilesystem service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -986,8 +1550,29 @@ var DeviceFilesystem_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BootFile",
 			Handler:    _DeviceFilesystem_BootFile_Handler,
 		},
+		{
+			MethodName: "WalkDirectory",
+			Handler:    _DeviceFilesystem_WalkDirectory_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "RemoveTree",
+			Handler:       _DeviceFilesystem_RemoveTree_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PutTree",
+			Handler:       _DeviceFilesystem_PutTree_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "GetTree",
+			Handler:       _DeviceFilesystem_GetTree_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
 
@@ -996,6 +1581,10 @@ var DeviceFilesystem_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeviceInfoClient interface {
 	FetchFields(ctx context.Context, in *FieldsRequest, opts ...grpc.CallOption) (*FieldsResponse, error)
+	// only available if DeviceCapability GetEmulationState is present
+	GetEmulationState(ctx context.Context, in *EmulationStateRequest, opts ...grpc.CallOption) (*EmulationStateResponse, error)
+	// polls the emulation state and sends a response whenever it changes, starting with the current state:
+	WatchEmulationState(ctx context.Context, in *WatchEmulationStateRequest, opts ...grpc.CallOption) (DeviceInfo_WatchEmulationStateClient, error)
 }
 
 type deviceInfoClient struct {
@@ -1015,11 +1604,56 @@ func (c *deviceInfoClient) FetchFields(ctx context.Context, in *FieldsRequest, o
 	return out, nil
 }
 
+func (c *deviceInfoClient) GetEmulationState(ctx context.Context, in *EmulationStateRequest, opts ...grpc.CallOption) (*EmulationStateResponse, error) {
+	out := new(EmulationStateResponse)
+	err := c.cc.Invoke(ctx, "/DeviceInfo/GetEmulationState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceInfoClient) WatchEmulationState(ctx context.Context, in *WatchEmulationStateRequest, opts ...grpc.CallOption) (DeviceInfo_WatchEmulationStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeviceInfo_ServiceDesc.Streams[0], "/DeviceInfo/WatchEmulationState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deviceInfoWatchEmulationStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeviceInfo_WatchEmulationStateClient interface {
+	Recv() (*EmulationStateResponse, error)
+	grpc.ClientStream
+}
+
+type deviceInfoWatchEmulationStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *deviceInfoWatchEmulationStateClient) Recv() (*EmulationStateResponse, error) {
+	m := new(EmulationStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DeviceInfoServer is the server API for DeviceInfo service.
 // All implementations must embed UnimplementedDeviceInfoServer
 // for forward compatibility
 type DeviceInfoServer interface {
 	FetchFields(context.Context, *FieldsRequest) (*FieldsResponse, error)
+	// only available if DeviceCapability GetEmulationState is present
+	GetEmulationState(context.Context, *EmulationStateRequest) (*EmulationStateResponse, error)
+	// polls the emulation state and sends a response whenever it changes, starting with the current state:
+	WatchEmulationState(*WatchEmulationStateRequest, DeviceInfo_WatchEmulationStateServer) error
 	mustEmbedUnimplementedDeviceInfoServer()
 }
 
@@ -1030,6 +1664,12 @@ type UnimplementedDeviceInfoServer struct {
 func (UnimplementedDeviceInfoServer) FetchFields(context.Context, *FieldsRequest) (*FieldsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchFields not implemented")
 }
+func (UnimplementedDeviceInfoServer) GetEmulationState(context.Context, *EmulationStateRequest) (*EmulationStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEmulationState not implemented")
+}
+func (UnimplementedDeviceInfoServer) WatchEmulationState(*WatchEmulationStateRequest, DeviceInfo_WatchEmulationStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEmulationState not implemented")
+}
 func (UnimplementedDeviceInfoServer) mustEmbedUnimplementedDeviceInfoServer() {}
 
 // UnsafeDeviceInfoServer may be embedded to opt out of forward compatibility for this service.
@@ -1061,6 +1701,45 @@ func _DeviceInfo_FetchFields_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceInfo_GetEmulationState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmulationStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceInfoServer).GetEmulationState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceInfo/GetEmulationState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceInfoServer).GetEmulationState(ctx, req.(*EmulationStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceInfo_WatchEmulationState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEmulationStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeviceInfoServer).WatchEmulationState(m, &deviceInfoWatchEmulationStateServer{stream})
+}
+
+type DeviceInfo_WatchEmulationStateServer interface {
+	Send(*EmulationStateResponse) error
+	grpc.ServerStream
+}
+
+type deviceInfoWatchEmulationStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *deviceInfoWatchEmulationStateServer) Send(m *EmulationStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DeviceInfo_ServiceDesc is the grpc.ServiceDesc for DeviceInfo service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1072,8 +1751,18 @@ var DeviceInfo_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FetchFields",
 			Handler:    _DeviceInfo_FetchFields_Handler,
 		},
+		{
+			MethodName: "GetEmulationState",
+			Handler:    _DeviceInfo_GetEmulationState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEmulationState",
+			Handler:       _DeviceInfo_WatchEmulationState_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
 }
 
@@ -1082,6 +1771,14 @@ var DeviceInfo_ServiceDesc = grpc.ServiceDesc{
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DeviceNWAClient interface {
 	NWACommand(ctx context.Context, in *NWACommandRequest, opts ...grpc.CallOption) (*NWACommandResponse, error)
+	// typed emu-nwaccess commands with parsed replies:
+	NWAEmulatorInfo(ctx context.Context, in *NWAEmulatorInfoRequest, opts ...grpc.CallOption) (*NWAEmulatorInfoResponse, error)
+	NWACoresList(ctx context.Context, in *NWACoresListRequest, opts ...grpc.CallOption) (*NWACoresListResponse, error)
+	NWACoreInfo(ctx context.Context, in *NWACoreInfoRequest, opts ...grpc.CallOption) (*NWACoreInfoResponse, error)
+	NWALoadCore(ctx context.Context, in *NWALoadCoreRequest, opts ...grpc.CallOption) (*NWALoadCoreResponse, error)
+	NWACoreMemories(ctx context.Context, in *NWACoreMemoriesRequest, opts ...grpc.CallOption) (*NWACoreMemoriesResponse, error)
+	NWALoadGame(ctx context.Context, in *NWALoadGameRequest, opts ...grpc.CallOption) (*NWALoadGameResponse, error)
+	NWAGameInfo(ctx context.Context, in *NWAGameInfoRequest, opts ...grpc.CallOption) (*NWAGameInfoResponse, error)
 }
 
 type deviceNWAClient struct {
@@ -1101,11 +1798,82 @@ func (c *deviceNWAClient) NWACommand(ctx context.Context, in *NWACommandRequest,
 	return out, nil
 }
 
+func (c *deviceNWAClient) NWAEmulatorInfo(ctx context.Context, in *NWAEmulatorInfoRequest, opts ...grpc.CallOption) (*NWAEmulatorInfoResponse, error) {
+	out := new(NWAEmulatorInfoResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWAEmulatorInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWACoresList(ctx context.Context, in *NWACoresListRequest, opts ...grpc.CallOption) (*NWACoresListResponse, error) {
+	out := new(NWACoresListResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWACoresList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWACoreInfo(ctx context.Context, in *NWACoreInfoRequest, opts ...grpc.CallOption) (*NWACoreInfoResponse, error) {
+	out := new(NWACoreInfoResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWACoreInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWALoadCore(ctx context.Context, in *NWALoadCoreRequest, opts ...grpc.CallOption) (*NWALoadCoreResponse, error) {
+	out := new(NWALoadCoreResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWALoadCore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWACoreMemories(ctx context.Context, in *NWACoreMemoriesRequest, opts ...grpc.CallOption) (*NWACoreMemoriesResponse, error) {
+	out := new(NWACoreMemoriesResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWACoreMemories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWALoadGame(ctx context.Context, in *NWALoadGameRequest, opts ...grpc.CallOption) (*NWALoadGameResponse, error) {
+	out := new(NWALoadGameResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWALoadGame", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceNWAClient) NWAGameInfo(ctx context.Context, in *NWAGameInfoRequest, opts ...grpc.CallOption) (*NWAGameInfoResponse, error) {
+	out := new(NWAGameInfoResponse)
+	err := c.cc.Invoke(ctx, "/DeviceNWA/NWAGameInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceNWAServer is the server API for DeviceNWA service.
 // All implementations must embed UnimplementedDeviceNWAServer
 // for forward compatibility
 type DeviceNWAServer interface {
 	NWACommand(context.Context, *NWACommandRequest) (*NWACommandResponse, error)
+	// typed emu-nwaccess commands with parsed replies:
+	NWAEmulatorInfo(context.Context, *NWAEmulatorInfoRequest) (*NWAEmulatorInfoResponse, error)
+	NWACoresList(context.Context, *NWACoresListRequest) (*NWACoresListResponse, error)
+	NWACoreInfo(context.Context, *NWACoreInfoRequest) (*NWACoreInfoResponse, error)
+	NWALoadCore(context.Context, *NWALoadCoreRequest) (*NWALoadCoreResponse, error)
+	NWACoreMemories(context.Context, *NWACoreMemoriesRequest) (*NWACoreMemoriesResponse, error)
+	NWALoadGame(context.Context, *NWALoadGameRequest) (*NWALoadGameResponse, error)
+	NWAGameInfo(context.Context, *NWAGameInfoRequest) (*NWAGameInfoResponse, error)
 	mustEmbedUnimplementedDeviceNWAServer()
 }
 
@@ -1116,6 +1884,27 @@ type UnimplementedDeviceNWAServer struct {
 func (UnimplementedDeviceNWAServer) NWACommand(context.Context, *NWACommandRequest) (*NWACommandResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NWACommand not implemented")
 }
+func (UnimplementedDeviceNWAServer) NWAEmulatorInfo(context.Context, *NWAEmulatorInfoRequest) (*NWAEmulatorInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWAEmulatorInfo not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWACoresList(context.Context, *NWACoresListRequest) (*NWACoresListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWACoresList not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWACoreInfo(context.Context, *NWACoreInfoRequest) (*NWACoreInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWACoreInfo not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWALoadCore(context.Context, *NWALoadCoreRequest) (*NWALoadCoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWALoadCore not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWACoreMemories(context.Context, *NWACoreMemoriesRequest) (*NWACoreMemoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWACoreMemories not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWALoadGame(context.Context, *NWALoadGameRequest) (*NWALoadGameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWALoadGame not implemented")
+}
+func (UnimplementedDeviceNWAServer) NWAGameInfo(context.Context, *NWAGameInfoRequest) (*NWAGameInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NWAGameInfo not implemented")
+}
 func (UnimplementedDeviceNWAServer) mustEmbedUnimplementedDeviceNWAServer() {}
 
 // UnsafeDeviceNWAServer may be embedded to opt out of forward compatibility for this service.
@@ -1147,6 +1936,132 @@ func _DeviceNWA_NWACommand_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceNWA_NWAEmulatorInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWAEmulatorInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWAEmulatorInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWAEmulatorInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWAEmulatorInfo(ctx, req.(*NWAEmulatorInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWACoresList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWACoresListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWACoresList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWACoresList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWACoresList(ctx, req.(*NWACoresListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWACoreInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWACoreInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWACoreInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWACoreInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWACoreInfo(ctx, req.(*NWACoreInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWALoadCore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWALoadCoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWALoadCore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWALoadCore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWALoadCore(ctx, req.(*NWALoadCoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWACoreMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWACoreMemoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWACoreMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWACoreMemories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWACoreMemories(ctx, req.(*NWACoreMemoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWALoadGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWALoadGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWALoadGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWALoadGame",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWALoadGame(ctx, req.(*NWALoadGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceNWA_NWAGameInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NWAGameInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceNWAServer).NWAGameInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/DeviceNWA/NWAGameInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceNWAServer).NWAGameInfo(ctx, req.(*NWAGameInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceNWA_ServiceDesc is the grpc.ServiceDesc for DeviceNWA service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1158,6 +2073,34 @@ var DeviceNWA_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NWACommand",
 			Handler:    _DeviceNWA_NWACommand_Handler,
 		},
+		{
+			MethodName: "NWAEmulatorInfo",
+			Handler:    _DeviceNWA_NWAEmulatorInfo_Handler,
+		},
+		{
+			MethodName: "NWACoresList",
+			Handler:    _DeviceNWA_NWACoresList_Handler,
+		},
+		{
+			MethodName: "NWACoreInfo",
+			Handler:    _DeviceNWA_NWACoreInfo_Handler,
+		},
+		{
+			MethodName: "NWALoadCore",
+			Handler:    _DeviceNWA_NWALoadCore_Handler,
+		},
+		{
+			MethodName: "NWACoreMemories",
+			Handler:    _DeviceNWA_NWACoreMemories_Handler,
+		},
+		{
+			MethodName: "NWALoadGame",
+			Handler:    _DeviceNWA_NWALoadGame_Handler,
+		},
+		{
+			MethodName: "NWAGameInfo",
+			Handler:    _DeviceNWA_NWAGameInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sni.proto",
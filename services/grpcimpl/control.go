@@ -3,7 +3,9 @@ package grpcimpl
 import (
 	"context"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"net"
 	"net/url"
 	"sni/devices"
 	"sni/protos/sni"
@@ -130,3 +132,252 @@ func (d *DeviceControlService) PauseToggleEmulation(gctx context.Context, reques
 
 	return
 }
+
+func (d *DeviceControlService) ExecuteASM(gctx context.Context, request *sni.ExecuteASMRequest) (grsp *sni.ExecuteASMResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ExecuteASM); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var result []byte
+	result, gerr = device.ExecuteASM(gctx, request.GetCode(), request.GetData(), int(request.GetResultSize()))
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ExecuteASMResponse{
+		Uri:    request.Uri,
+		Result: result,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) SaveState(gctx context.Context, request *sni.SaveStateRequest) (grsp *sni.SaveStateResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_SaveState); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var state []byte
+	state, gerr = device.SaveState(gctx, devices.SaveStateTarget{
+		Slot:     int(request.GetSlot()),
+		Path:     request.GetPath(),
+		Transfer: request.GetTransfer(),
+	})
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.SaveStateResponse{
+		Uri:   request.Uri,
+		State: state,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) LoadState(gctx context.Context, request *sni.LoadStateRequest) (grsp *sni.LoadStateResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_SaveState); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.LoadState(gctx, devices.SaveStateTarget{
+		Slot:     int(request.GetSlot()),
+		Path:     request.GetPath(),
+		Transfer: request.GetState() != nil,
+		State:    request.GetState(),
+	})
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.LoadStateResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) Screenshot(gctx context.Context, request *sni.ScreenshotRequest) (grsp *sni.ScreenshotResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_Screenshot); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	grsp = &sni.ScreenshotResponse{
+		Uri: request.Uri,
+	}
+	grsp.Image, grsp.MimeType, grsp.Path, gerr = device.Screenshot(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	return
+}
+
+func (d *DeviceControlService) FrameAdvance(gctx context.Context, request *sni.FrameAdvanceRequest) (grsp *sni.FrameAdvanceResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_FrameAdvance); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	frames := int(request.GetFrames())
+	if frames == 0 {
+		frames = 1
+	}
+	gerr = device.FrameAdvance(gctx, frames)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.FrameAdvanceResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) SetEmulationSpeed(gctx context.Context, request *sni.SetEmulationSpeedRequest) (grsp *sni.SetEmulationSpeedResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !(request.GetSpeed() > 0) {
+		return nil, status.Error(codes.InvalidArgument, "speed must be greater than 0")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_SetEmulationSpeed); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.SetEmulationSpeed(gctx, request.GetSpeed())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.SetEmulationSpeedResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceControlService) BootRom(gctx context.Context, request *sni.BootRomRequest) (grsp *sni.BootRomResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if len(request.GetRom()) == 0 && request.GetHostPath() == "" {
+		return nil, status.Error(codes.InvalidArgument, "either rom or hostPath is required")
+	}
+	// the servers listen on all interfaces; only clients on this host may name files on it:
+	if request.GetHostPath() != "" && !isLoopbackPeer(gctx) {
+		return nil, status.Error(codes.PermissionDenied, "hostPath is only accepted from clients on SNI's host")
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_BootRom); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var path string
+	path, gerr = device.BootRom(gctx, devices.BootRomSource{
+		Rom:      request.GetRom(),
+		HostPath: request.GetHostPath(),
+		FileName: request.GetFileName(),
+	})
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.BootRomResponse{
+		Uri:  request.Uri,
+		Path: path,
+	}
+
+	return
+}
+
+// isLoopbackPeer reports whether the client of the request connected from this host:
+func isLoopbackPeer(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
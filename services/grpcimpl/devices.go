@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"net/url"
+	"sni/cmd/sni/config"
 	"sni/cmd/sni/tray"
 	"sni/devices"
 	"sni/protos/sni"
+	"time"
 )
 
 func grpcError(err error) error {
@@ -60,12 +64,74 @@ func (s *DevicesService) ListDevices(ctx context.Context, request *sni.DevicesRe
 			Kind:                descriptor.Kind,
 			Capabilities:        descriptor.Capabilities,
 			DefaultAddressSpace: descriptor.DefaultAddressSpace,
+			Id:                  descriptor.Id,
 		})
 	}
 
 	return &sni.DevicesResponse{Devices: devs}, nil
 }
 
+func (s *DevicesService) BenchmarkDevice(ctx context.Context, request *sni.BenchmarkDeviceRequest) (grsp *sni.BenchmarkDeviceResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	driver, gerr = devices.DeviceDriverByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	benchmarker, ok := driver.(devices.DriverBaudRateBenchmarker)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "driver '%s' does not support benchmarking baud rates", driver.Kind())
+	}
+
+	rates := make([]int, 0, len(request.BaudRates))
+	for _, baud := range request.BaudRates {
+		rates = append(rates, int(baud))
+	}
+
+	var results []devices.BaudRateResult
+	var best int
+	results, best, gerr = benchmarker.BenchmarkBaudRates(
+		ctx,
+		uri,
+		rates,
+		time.Duration(request.DurationMs)*time.Millisecond,
+	)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if best != 0 {
+		// open the device at the best rate from now on:
+		benchmarker.RememberBaudRate(uri, best)
+		config.Save()
+	}
+
+	grsp = &sni.BenchmarkDeviceResponse{
+		Uri:          request.Uri,
+		Results:      make([]*sni.BenchmarkDeviceResponse_Result, 0, len(results)),
+		BestBaudRate: uint32(best),
+	}
+	for _, result := range results {
+		r := &sni.BenchmarkDeviceResponse_Result{
+			BaudRate:       uint32(result.BaudRate),
+			BytesPerSecond: result.BytesPerSecond,
+			Transfers:      uint32(result.Transfers),
+			Errors:         uint32(result.Errors),
+		}
+		if result.Err != nil {
+			r.Error = result.Err.Error()
+		}
+		grsp.Results = append(grsp.Results, r)
+	}
+
+	return
+}
+
 func (s *DevicesService) MethodRequestString(method string, req interface{}) string {
 	if req == nil {
 		return "nil"
@@ -5,6 +5,7 @@ import (
 	"context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"io"
 	"net/url"
 	"sni/devices"
 	"sni/protos/sni"
@@ -160,23 +161,89 @@ func (d *DeviceFilesystem) PutFile(ctx context.Context, request *sni.PutFileRequ
 		return nil, status.Error(codes.Unimplemented, err.Error())
 	}
 
+	options := devices.PutFileOptions{
+		Verify: request.GetVerify(),
+		Atomic: request.GetAtomic(),
+	}
+	if options.Verify {
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_GetFile); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+	}
+	if options.Atomic {
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_RemoveFile, sni.DeviceCapability_RenameFile); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+	}
+
+	if request.GetResume() {
+		return d.putFileResumable(ctx, uri, device, request, options)
+	}
+
 	var n uint32
-	n, gerr = device.PutFile(
+	n, gerr = devices.PutFileWithOptions(
 		ctx,
+		device,
 		request.GetPath(),
 		uint32(len(request.GetData())),
 		bytes.NewReader(request.GetData()),
+		options,
 		nil,
 	)
 	if gerr != nil {
-		return
+		return nil, grpcError(gerr)
 	}
-	_ = n
 
 	// translate response:
 	grsp = &sni.PutFileResponse{
-		Uri:  request.Uri,
-		Path: request.Path,
+		Uri:      request.Uri,
+		Path:     request.Path,
+		Size:     n,
+		Received: n,
+	}
+	return
+}
+
+func (d *DeviceFilesystem) putFileResumable(
+	ctx context.Context,
+	uri *url.URL,
+	device devices.AutoCloseableDevice,
+	request *sni.PutFileRequest,
+	options devices.PutFileOptions,
+) (grsp *sni.PutFileResponse, gerr error) {
+	totalSize := request.GetTotalSize()
+
+	var received uint32
+	received, gerr = devices.StagedUploadSize(uri, request.GetPath(), totalSize)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	offset := received
+	if request.Offset != nil {
+		offset = request.GetOffset()
+	}
+
+	data := request.GetData()
+	if offset != received || len(data) > 0 {
+		received, gerr = devices.StageUpload(uri, request.GetPath(), totalSize, offset, bytes.NewReader(data), uint32(len(data)))
+		if gerr != nil {
+			return nil, grpcError(gerr)
+		}
+	}
+
+	grsp = &sni.PutFileResponse{
+		Uri:      request.Uri,
+		Path:     request.Path,
+		Received: received,
+	}
+	if received < totalSize {
+		return
+	}
+
+	grsp.Size, gerr = devices.PutStagedUpload(ctx, device, uri, request.GetPath(), totalSize, options, nil)
+	if gerr != nil {
+		return nil, grpcError(gerr)
 	}
 	return
 }
@@ -249,3 +316,212 @@ func (d *DeviceFilesystem) BootFile(ctx context.Context, request *sni.BootFileRe
 	}
 	return
 }
+
+func treeEntry(entry devices.TreeEntry) (gentry *sni.TreeEntry) {
+	gentry = &sni.TreeEntry{
+		Path: entry.Path,
+		Type: entry.Type,
+		Size: entry.Size,
+	}
+	if entry.Err != nil {
+		gentry.Error = entry.Err.Error()
+	}
+	return
+}
+
+func treeProgress(entry devices.TreeEntry, done int, total int) *sni.TreeProgress {
+	return &sni.TreeProgress{
+		Entry: treeEntry(entry),
+		Done:  uint32(done),
+		Total: uint32(total),
+	}
+}
+
+func (d *DeviceFilesystem) WalkDirectory(ctx context.Context, request *sni.WalkDirectoryRequest) (grsp *sni.WalkDirectoryResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadDirectory); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var entries []devices.TreeEntry
+	entries, gerr = devices.WalkDirectory(ctx, device, request.GetPath())
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	// translate response:
+	grsp = &sni.WalkDirectoryResponse{
+		Uri:     request.Uri,
+		Path:    request.Path,
+		Entries: make([]*sni.TreeEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		grsp.Entries[i] = treeEntry(entry)
+	}
+	return
+}
+
+func (d *DeviceFilesystem) RemoveTree(request *sni.RemoveTreeRequest, stream sni.DeviceFilesystem_RemoveTreeServer) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadDirectory, sni.DeviceCapability_RemoveFile); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = devices.RemoveTree(stream.Context(), device, request.GetPath(), func(entry devices.TreeEntry, done int, total int) {
+		_ = stream.Send(&sni.RemoveTreeResponse{
+			Uri:      request.Uri,
+			Path:     request.Path,
+			Progress: treeProgress(entry, done, total),
+		})
+	})
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+	return
+}
+
+func (d *DeviceFilesystem) PutTree(stream sni.DeviceFilesystem_PutTreeServer) (gerr error) {
+	var request *sni.PutTreeRequest
+	request, gerr = stream.Recv()
+	if gerr != nil {
+		return
+	}
+
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(
+		sni.DeviceCapability_ReadDirectory,
+		sni.DeviceCapability_MakeDirectory,
+		sni.DeviceCapability_PutFile,
+	); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	// feed the archive chunks received from the client to the extractor:
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		in := request
+		for {
+			if _, err := pw.Write(in.GetArchive()); err != nil {
+				return
+			}
+
+			var err error
+			in, err = stream.Recv()
+			if err == io.EOF {
+				_ = pw.Close()
+				return
+			}
+			if err != nil {
+				_ = pw.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	gerr = devices.PutTree(stream.Context(), device, request.GetPath(), pr, func(entry devices.TreeEntry, done int, total int) {
+		_ = stream.Send(&sni.PutTreeResponse{
+			Uri:      request.Uri,
+			Path:     request.Path,
+			Progress: treeProgress(entry, done, total),
+		})
+	})
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+	return
+}
+
+// treeArchiveWriter sends the archive written to it to the client in chunks that fit in a gRPC message.
+type treeArchiveWriter struct {
+	request *sni.GetTreeRequest
+	stream  sni.DeviceFilesystem_GetTreeServer
+}
+
+const treeArchiveChunkSize = 1024 * 1024
+
+func (w *treeArchiveWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > treeArchiveChunkSize {
+			chunk = chunk[:treeArchiveChunkSize]
+		}
+
+		err = w.stream.Send(&sni.GetTreeResponse{
+			Uri:     w.request.Uri,
+			Path:    w.request.Path,
+			Archive: chunk,
+		})
+		if err != nil {
+			return
+		}
+
+		n += len(chunk)
+		p = p[len(chunk):]
+	}
+	return
+}
+
+func (d *DeviceFilesystem) GetTree(request *sni.GetTreeRequest, stream sni.DeviceFilesystem_GetTreeServer) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadDirectory, sni.DeviceCapability_GetFile); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	w := &treeArchiveWriter{request: request, stream: stream}
+	gerr = devices.GetTree(stream.Context(), device, request.GetPath(), w, func(entry devices.TreeEntry, done int, total int) {
+		_ = stream.Send(&sni.GetTreeResponse{
+			Uri:      request.Uri,
+			Path:     request.Path,
+			Progress: treeProgress(entry, done, total),
+		})
+	})
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+	return
+}
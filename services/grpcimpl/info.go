@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"sni/devices"
 	"sni/protos/sni"
+	"time"
 )
 
 type DeviceInfoService struct {
@@ -48,3 +49,90 @@ func (d *DeviceInfoService) FetchFields(gctx context.Context, request *sni.Field
 
 	return
 }
+
+func (d *DeviceInfoService) GetEmulationState(gctx context.Context, request *sni.EmulationStateRequest) (grsp *sni.EmulationStateResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_GetEmulationState); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var emuStatus devices.EmulationStatus
+	emuStatus, gerr = device.GetEmulationState(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = emulationStateResponse(request.Uri, emuStatus)
+	return
+}
+
+func (d *DeviceInfoService) WatchEmulationState(request *sni.WatchEmulationStateRequest, stream sni.DeviceInfo_WatchEmulationStateServer) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_GetEmulationState); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	interval := time.Duration(request.GetIntervalMs()) * time.Millisecond
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ctx := stream.Context()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	first := true
+	var last devices.EmulationStatus
+	for {
+		var emuStatus devices.EmulationStatus
+		emuStatus, gerr = device.GetEmulationState(ctx)
+		if gerr != nil {
+			return grpcError(gerr)
+		}
+
+		if first || emuStatus != last {
+			gerr = stream.Send(emulationStateResponse(request.Uri, emuStatus))
+			if gerr != nil {
+				return
+			}
+			first, last = false, emuStatus
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func emulationStateResponse(uri string, emuStatus devices.EmulationStatus) *sni.EmulationStateResponse {
+	return &sni.EmulationStateResponse{
+		Uri:         uri,
+		State:       emuStatus.State,
+		RomFileName: emuStatus.RomFileName,
+		CoreName:    emuStatus.CoreName,
+	}
+}
@@ -65,6 +65,47 @@ func (s *DeviceMemoryService) MappingDetect(gctx context.Context, request *sni.D
 	return
 }
 
+func (s *DeviceMemoryService) ListMemoryRegions(gctx context.Context, request *sni.ListMemoryRegionsRequest) (grsp *sni.ListMemoryRegionsResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ListMemoryRegions); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var regions []devices.MemoryRegion
+	regions, gerr = device.ListMemoryRegions(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.ListMemoryRegionsResponse{
+		Uri:     request.GetUri(),
+		Regions: make([]*sni.MemoryRegion, 0, len(regions)),
+	}
+	for _, region := range regions {
+		grsp.Regions = append(grsp.Regions, &sni.MemoryRegion{
+			Name:         region.Name,
+			AddressSpace: region.AddressSpace,
+			Address:      region.Address,
+			Size:         region.Size,
+			Readable:     region.Readable,
+			Writable:     region.Writable,
+		})
+	}
+
+	return
+}
+
 func (s *DeviceMemoryService) SingleRead(
 	gctx context.Context,
 	request *sni.SingleReadMemoryRequest,
@@ -206,7 +247,6 @@ func (s *DeviceMemoryService) MultiRead(
 		return nil, status.Error(codes.Unimplemented, err.Error())
 	}
 
-	var grsps []*sni.ReadMemoryResponse
 	reads := make([]devices.MemoryReadRequest, 0, len(request.Requests))
 	for _, req := range request.Requests {
 		reads = append(reads, devices.MemoryReadRequest{
@@ -224,6 +264,22 @@ func (s *DeviceMemoryService) MultiRead(
 	if gerr != nil {
 		return nil, grpcError(gerr)
 	}
+
+	var grsps []*sni.ReadMemoryResponse
+	grsps, gerr = readMemoryResponses(reads, mrsps)
+	if gerr != nil {
+		return
+	}
+
+	grsp = &sni.MultiReadMemoryResponse{
+		Uri:       request.Uri,
+		Responses: grsps,
+	}
+
+	return
+}
+
+func readMemoryResponses(reads []devices.MemoryReadRequest, mrsps []devices.MemoryReadResponse) (grsps []*sni.ReadMemoryResponse, gerr error) {
 	if actual, expected := len(mrsps), len(reads); actual != expected {
 		gerr = status.Errorf(
 			codes.Internal,
@@ -257,11 +313,6 @@ func (s *DeviceMemoryService) MultiRead(
 		})
 	}
 
-	grsp = &sni.MultiReadMemoryResponse{
-		Uri:       request.Uri,
-		Responses: grsps,
-	}
-
 	return
 }
 
@@ -298,7 +349,14 @@ func (s *DeviceMemoryService) MultiWrite(
 	}
 
 	var mrsps []devices.MemoryWriteResponse
-	mrsps, gerr = device.MultiWriteMemory(gctx, writes...)
+	if request.GetVerify() {
+		if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+			return nil, status.Error(codes.Unimplemented, err.Error())
+		}
+		mrsps, gerr = devices.MultiWriteMemoryVerified(gctx, device, writes...)
+	} else {
+		mrsps, gerr = device.MultiWriteMemory(gctx, writes...)
+	}
 	if gerr != nil {
 		return nil, grpcError(gerr)
 	}
@@ -325,14 +383,18 @@ func (s *DeviceMemoryService) MultiWrite(
 			return
 		}
 
-		grsps = append(grsps, &sni.WriteMemoryResponse{
+		grsp := &sni.WriteMemoryResponse{
 			RequestAddress:       mrsp.RequestAddress.Address,
 			RequestAddressSpace:  mrsp.RequestAddress.AddressSpace,
 			RequestMemoryMapping: mrsp.RequestAddress.MemoryMapping,
 			DeviceAddress:        mrsp.DeviceAddress.Address,
 			DeviceAddressSpace:   mrsp.DeviceAddress.AddressSpace,
 			Size:                 uint32(mrsp.Size),
-		})
+		}
+		if mrsp.VerifyError != nil {
+			grsp.VerifyError = mrsp.VerifyError.Error()
+		}
+		grsps = append(grsps, grsp)
 	}
 
 	grsp = &sni.MultiWriteMemoryResponse{
@@ -342,16 +404,80 @@ func (s *DeviceMemoryService) MultiWrite(
 	return
 }
 
-func (s *DeviceMemoryService) StreamRead(stream sni.DeviceMemory_StreamReadServer) error {
+type streamReadRecv struct {
+	in  *sni.MultiReadMemoryRequest
+	err error
+}
+
+func (s *DeviceMemoryService) StreamRead(stream sni.DeviceMemory_StreamReadServer) (err error) {
+	ctx := stream.Context()
+
+	// receive requests in the background so that an active subscription can keep sending responses:
+	recvs := make(chan streamReadRecv)
+	go func() {
+		for {
+			in, err := stream.Recv()
+			select {
+			case recvs <- streamReadRecv{in: in, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	// the active subscription, if any, is canceled by the next request:
+	subCancel := context.CancelFunc(func() {})
+	var subDone chan error
+	stopSubscription := func() (serr error) {
+		subCancel()
+		if subDone != nil {
+			serr = <-subDone
+			subDone = nil
+		}
+		return
+	}
+	defer func() {
+		_ = stopSubscription()
+	}()
+
 	for {
-		in, err := stream.Recv()
-		if err == io.EOF {
-			return nil
+		var r streamReadRecv
+		select {
+		case err = <-subDone:
+			subDone = nil
+			if err != nil {
+				return
+			}
+			continue
+		case r = <-recvs:
 		}
+
+		err = stopSubscription()
 		if err != nil {
-			return err
+			return
+		}
+
+		if r.err == io.EOF {
+			return nil
+		}
+		if r.err != nil {
+			return r.err
+		}
+
+		if r.in.GetSubscribe() {
+			subCtx, cancel := context.WithCancel(ctx)
+			subCancel = cancel
+			subDone = make(chan error, 1)
+			go func(in *sni.MultiReadMemoryRequest, done chan<- error) {
+				done <- s.subscribeRead(subCtx, stream, in)
+			}(r.in, subDone)
+			continue
 		}
-		grsp, gerr := s.MultiRead(stream.Context(), in)
+
+		grsp, gerr := s.MultiRead(ctx, r.in)
 		if gerr != nil {
 			// TODO: stream errors as responses?
 			return gerr
@@ -363,6 +489,59 @@ func (s *DeviceMemoryService) StreamRead(stream sni.DeviceMemory_StreamReadServe
 	}
 }
 
+// subscribeRead sends a response to the stream for every frame of data streamed from the device until ctx is canceled:
+func (s *DeviceMemoryService) subscribeRead(
+	ctx context.Context,
+	stream sni.DeviceMemory_StreamReadServer,
+	request *sni.MultiReadMemoryRequest,
+) (gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_ReadMemory); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	reads := make([]devices.MemoryReadRequest, 0, len(request.Requests))
+	for _, req := range request.Requests {
+		reads = append(reads, devices.MemoryReadRequest{
+			RequestAddress: devices.AddressTuple{
+				Address:       req.GetRequestAddress(),
+				AddressSpace:  req.GetRequestAddressSpace(),
+				MemoryMapping: req.GetRequestMemoryMapping(),
+			},
+			Size: int(req.GetSize()),
+		})
+	}
+
+	gerr = device.StreamReadMemory(ctx, func(mrsps []devices.MemoryReadResponse) (err error) {
+		var grsps []*sni.ReadMemoryResponse
+		grsps, err = readMemoryResponses(reads, mrsps)
+		if err != nil {
+			return
+		}
+
+		return stream.Send(&sni.MultiReadMemoryResponse{
+			Uri:       request.Uri,
+			Responses: grsps,
+		})
+	}, reads...)
+	if gerr != nil {
+		return grpcError(gerr)
+	}
+
+	return
+}
+
 func (s *DeviceMemoryService) StreamWrite(stream sni.DeviceMemory_StreamWriteServer) error {
 	for {
 		in, err := stream.Recv()
@@ -53,3 +53,242 @@ func (d *DeviceNWAService) NWACommand(gctx context.Context, request *sni.NWAComm
 
 	return
 }
+
+func (d *DeviceNWAService) NWAEmulatorInfo(gctx context.Context, request *sni.NWAEmulatorInfoRequest) (grsp *sni.NWAEmulatorInfoResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var info devices.NWAEmulatorInfo
+	info, gerr = device.NWAEmulatorInfo(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWAEmulatorInfoResponse{
+		Uri:        request.Uri,
+		Name:       info.Name,
+		Version:    info.Version,
+		NwaVersion: info.NWAVersion,
+		Id:         info.Id,
+		Commands:   info.Commands,
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWACoresList(gctx context.Context, request *sni.NWACoresListRequest) (grsp *sni.NWACoresListResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var cores []devices.NWACore
+	cores, gerr = device.NWACoresList(gctx, request.Platform)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWACoresListResponse{
+		Uri:   request.Uri,
+		Cores: make([]*sni.NWACore, 0, len(cores)),
+	}
+	for _, core := range cores {
+		grsp.Cores = append(grsp.Cores, nwaCore(core))
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWACoreInfo(gctx context.Context, request *sni.NWACoreInfoRequest) (grsp *sni.NWACoreInfoResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var core devices.NWACore
+	core, gerr = device.NWACoreInfo(gctx, request.Name)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWACoreInfoResponse{
+		Uri:  request.Uri,
+		Core: nwaCore(core),
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWALoadCore(gctx context.Context, request *sni.NWALoadCoreRequest) (grsp *sni.NWALoadCoreResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.NWALoadCore(gctx, request.Name)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWALoadCoreResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWACoreMemories(gctx context.Context, request *sni.NWACoreMemoriesRequest) (grsp *sni.NWACoreMemoriesResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var memories []devices.NWACoreMemory
+	memories, gerr = device.NWACoreMemories(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWACoreMemoriesResponse{
+		Uri:      request.Uri,
+		Memories: make([]*sni.NWACoreMemory, 0, len(memories)),
+	}
+	for _, memory := range memories {
+		grsp.Memories = append(grsp.Memories, &sni.NWACoreMemory{
+			Name:   memory.Name,
+			Access: memory.Access,
+			Size:   memory.Size,
+		})
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWALoadGame(gctx context.Context, request *sni.NWALoadGameRequest) (grsp *sni.NWALoadGameResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	gerr = device.NWALoadGame(gctx, request.Path)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWALoadGameResponse{
+		Uri: request.Uri,
+	}
+
+	return
+}
+
+func (d *DeviceNWAService) NWAGameInfo(gctx context.Context, request *sni.NWAGameInfoRequest) (grsp *sni.NWAGameInfoResponse, gerr error) {
+	uri, err := url.Parse(request.GetUri())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var driver devices.Driver
+	var device devices.AutoCloseableDevice
+	driver, device, gerr = devices.DeviceByUri(uri)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	if _, err := driver.HasCapabilities(sni.DeviceCapability_NWACommand); err != nil {
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+
+	var info devices.NWAGameInfo
+	info, gerr = device.NWAGameInfo(gctx)
+	if gerr != nil {
+		return nil, grpcError(gerr)
+	}
+
+	grsp = &sni.NWAGameInfoResponse{
+		Uri:    request.Uri,
+		Name:   info.Name,
+		File:   info.File,
+		Region: info.Region,
+		Type:   info.Type,
+	}
+
+	return
+}
+
+func nwaCore(core devices.NWACore) *sni.NWACore {
+	return &sni.NWACore{
+		Name:     core.Name,
+		Platform: core.Platform,
+		Version:  core.Version,
+		File:     core.File,
+	}
+}
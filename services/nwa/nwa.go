@@ -0,0 +1,611 @@
+package nwa
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/alttpo/snes"
+	"io"
+	"log"
+	"net"
+	"net/url"
+	"path"
+	"sni/cmd/sni/appversion"
+	"sni/cmd/sni/config"
+	"sni/devices"
+	"sni/devices/snes/mapping"
+	"sni/protos/sni"
+	"sni/util"
+	"sni/util/env"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// emulatorName is the name SNI reports in EMULATOR_INFO; the emunwa driver does not detect emulators by this name so
+// that SNI never attaches to itself.
+const emulatorName = "SNI"
+
+const nwaVersion = "1.0"
+
+// commands lists the NWA commands the server implements:
+var commands = []string{
+	"EMULATOR_INFO",
+	"EMULATION_STATUS",
+	"EMULATION_RESET",
+	"EMULATION_PAUSE",
+	"EMULATION_RESUME",
+	"GAME_INFO",
+	"CORE_CURRENT_INFO",
+	"CORE_MEMORIES",
+	"CORE_READ",
+	"CORE_WRITE",
+}
+
+// memoryTypes lists the NWA memory domains the server translates to the FX Pak Pro address space:
+var memoryTypes = []mapping.MemoryType{
+	mapping.MemoryTypeWRAM,
+	mapping.MemoryTypeSRAM,
+	mapping.MemoryTypeROM,
+}
+
+// maxBinaryBlockSize is the size of the largest memory domain, CARTROM; larger binary arguments cannot be valid and
+// are refused before anything is allocated for them:
+const maxBinaryBlockSize = 0xE00000
+
+// commandTimeout limits how long a command may take on the device:
+const commandTimeout = time.Second * 5
+
+// StartServer starts the emu-nwaccess server on the addresses listed in SNI_NWA_LISTEN_ADDRS, if any, so that tools
+// which only speak NWA can use any device attached to SNI.
+func StartServer() {
+	addrList := env.GetOrDefault("SNI_NWA_LISTEN_ADDRS", "")
+	if addrList == "" {
+		return
+	}
+
+	for _, listenAddr := range strings.Split(addrList, ",") {
+		listenAddr = strings.TrimSpace(listenAddr)
+		if listenAddr == "" {
+			continue
+		}
+		go func(listenAddr string) {
+			for {
+				listenTcp(listenAddr)
+			}
+		}(listenAddr)
+	}
+}
+
+func listenTcp(listenAddr string) {
+	defer util.Recover()
+
+	var err error
+	var lis net.Listener
+
+	// attempt to start the nwa server:
+	count := 0
+	lc := &net.ListenConfig{Control: util.ReusePortControl}
+	for {
+		lis, err = lc.Listen(context.Background(), "tcp", listenAddr)
+		if err == nil {
+			break
+		}
+
+		if count == 0 {
+			log.Printf("nwa: failed to listen on %s: %v\n", listenAddr, err)
+		}
+		count++
+		if count >= 30 {
+			count = 0
+		}
+
+		time.Sleep(time.Second)
+	}
+
+	log.Printf("nwa: listening on %s\n", listenAddr)
+	for {
+		var conn net.Conn
+		conn, err = lis.Accept()
+		if err != nil {
+			break
+		}
+		go serveConn(conn)
+	}
+	_ = lis.Close()
+	log.Printf("nwa: exit listenTcp: %v\n", err)
+}
+
+// replyError is sent to the client as an `error:` reply; kind is one of the NWA error kinds, e.g. "invalid_argument".
+type replyError struct {
+	kind   string
+	reason string
+}
+
+func (e *replyError) Error() string { return e.kind + ": " + e.reason }
+
+func invalidArgument(format string, args ...interface{}) error {
+	return &replyError{"invalid_argument", fmt.Sprintf(format, args...)}
+}
+
+// pair is a key:value line of an ASCII reply; items of a list repeat the same keys.
+type pair struct {
+	key   string
+	value string
+}
+
+// session is the state of one client connection:
+type session struct {
+	clientName string
+	w          *bufio.Writer
+
+	uri           *url.URL
+	driver        devices.Driver
+	device        devices.AutoCloseableDevice
+	memoryMapping sni.MemoryMapping
+}
+
+func serveConn(conn net.Conn) {
+	defer util.Recover()
+
+	s := &session{
+		clientName: conn.RemoteAddr().String(),
+		w:          bufio.NewWriter(conn),
+	}
+	defer func() {
+		log.Printf("nwa: %s: disconnected\n", s.clientName)
+		_ = conn.Close()
+	}()
+	log.Printf("nwa: %s: connected\n", s.clientName)
+
+	r := bufio.NewReader(conn)
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err != io.EOF {
+				log.Printf("nwa: %s: error reading command: %v\n", s.clientName, err)
+			}
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			continue
+		}
+
+		// commands prefixed with 'b' are followed by a binary argument block:
+		var data []byte
+		if line[0] == 'b' {
+			line = line[1:]
+			data, err = readBinaryBlock(r)
+			if err != nil {
+				log.Printf("nwa: %s: error reading binary argument: %v\n", s.clientName, err)
+				return
+			}
+		}
+
+		cmd, args := line, ""
+		if i := strings.IndexByte(line, ' '); i >= 0 {
+			cmd, args = line[:i], line[i+1:]
+		}
+		if config.VerboseLogging {
+			log.Printf("nwa: %s: %s %s\n", s.clientName, cmd, args)
+		}
+
+		err = s.handle(cmd, args, data)
+		if err != nil {
+			var rerr *replyError
+			if !errors.As(err, &rerr) {
+				rerr = &replyError{"command_error", err.Error()}
+			}
+			if config.VerboseLogging {
+				log.Printf("nwa: %s: %s error: %v\n", s.clientName, cmd, rerr)
+			}
+			s.writeASCII(pair{"error", rerr.kind}, pair{"reason", rerr.reason})
+		}
+
+		if err = s.w.Flush(); err != nil {
+			log.Printf("nwa: %s: error writing reply: %v\n", s.clientName, err)
+			return
+		}
+	}
+}
+
+func readBinaryBlock(r *bufio.Reader) (data []byte, err error) {
+	var d byte
+	d, err = r.ReadByte()
+	if err != nil {
+		return
+	}
+	if d != 0 {
+		err = fmt.Errorf("binary block must start with '\\0' but got '%c'", d)
+		return
+	}
+
+	var size uint32
+	err = binary.Read(r, binary.BigEndian, &size)
+	if err != nil {
+		return
+	}
+	if size > maxBinaryBlockSize {
+		err = fmt.Errorf("binary block of $%x bytes exceeds the maximum of $%x", size, maxBinaryBlockSize)
+		return
+	}
+	data = make([]byte, size)
+	_, err = io.ReadFull(r, data)
+	return
+}
+
+func (s *session) writeASCII(pairs ...pair) {
+	_ = s.w.WriteByte('\n')
+	for _, p := range pairs {
+		_, _ = fmt.Fprintf(s.w, "%s:%s\n", p.key, p.value)
+	}
+	_ = s.w.WriteByte('\n')
+}
+
+func (s *session) writeBinary(data []byte) {
+	_ = s.w.WriteByte(0)
+	_ = binary.Write(s.w, binary.BigEndian, uint32(len(data)))
+	_, _ = s.w.Write(data)
+}
+
+func (s *session) handle(cmd string, args string, data []byte) (err error) {
+	if cmd == "EMULATOR_INFO" {
+		s.writeASCII(
+			pair{"name", emulatorName},
+			pair{"version", appversion.Version},
+			pair{"nwa_version", nwaVersion},
+			pair{"id", "sni"},
+			pair{"commands", strings.Join(commands, ",")},
+		)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	err = s.attach(ctx)
+	if err != nil {
+		return
+	}
+
+	switch cmd {
+	case "EMULATION_STATUS":
+		err = s.emulationStatus(ctx)
+	case "EMULATION_RESET":
+		err = s.requireCapability(sni.DeviceCapability_ResetSystem)
+		if err == nil {
+			err = s.device.ResetSystem(ctx)
+		}
+		if err == nil {
+			s.writeASCII()
+		}
+	case "EMULATION_PAUSE", "EMULATION_RESUME":
+		err = s.requireCapability(sni.DeviceCapability_PauseUnpauseEmulation)
+		if err == nil {
+			_, err = s.device.PauseUnpause(ctx, cmd == "EMULATION_PAUSE")
+		}
+		if err == nil {
+			s.writeASCII()
+		}
+	case "GAME_INFO":
+		err = s.gameInfo(ctx)
+	case "CORE_CURRENT_INFO":
+		s.writeASCII(pair{"name", s.uri.Scheme}, pair{"platform", "SNES"})
+	case "CORE_MEMORIES":
+		pairs := make([]pair, 0, len(memoryTypes)*3)
+		for _, memoryType := range memoryTypes {
+			_, size, _ := mapping.PakAddressForMemoryType(memoryType, 0)
+			pairs = append(
+				pairs,
+				pair{"name", string(memoryType)},
+				pair{"access", "rw"},
+				pair{"size", strconv.FormatUint(uint64(size), 10)},
+			)
+		}
+		s.writeASCII(pairs...)
+	case "CORE_READ":
+		err = s.coreRead(ctx, args)
+	case "CORE_WRITE":
+		err = s.coreWrite(ctx, args, data)
+	default:
+		err = &replyError{"invalid_command", fmt.Sprintf("unknown command '%s'", cmd)}
+	}
+
+	// drop the device after fatal errors so the next command attaches again:
+	if err != nil && devices.IsFatal(err) {
+		s.device = nil
+	}
+	return
+}
+
+// attach attaches to the device named by SNI_NWA_DEVICE or else the first device SNI detects:
+func (s *session) attach(ctx context.Context) (err error) {
+	if s.device != nil {
+		return
+	}
+
+	var uri *url.URL
+	if uriString := env.GetOrDefault("SNI_NWA_DEVICE", ""); uriString != "" {
+		uri, err = url.Parse(uriString)
+		if err != nil {
+			return &replyError{"command_error", fmt.Sprintf("bad SNI_NWA_DEVICE uri '%s': %v", uriString, err)}
+		}
+	} else {
+	detect:
+		for _, driver := range devices.Drivers() {
+			descriptors, derr := driver.Driver.Detect()
+			if derr != nil {
+				continue
+			}
+			for _, descriptor := range descriptors {
+				uri = &url.URL{}
+				*uri = descriptor.Uri
+				break detect
+			}
+		}
+		if uri == nil {
+			return &replyError{"command_error", "no device is connected to SNI"}
+		}
+	}
+
+	s.driver, s.device, err = devices.DeviceByUri(uri)
+	if err != nil {
+		return
+	}
+	s.uri = uri
+	log.Printf("nwa: %s: attached to %s\n", s.clientName, uri)
+
+	s.memoryMapping = sni.MemoryMapping_Unknown
+
+	var requiresMemoryMapping bool
+	requiresMemoryMapping, err = s.device.RequiresMemoryMappingForAddressSpace(ctx, sni.AddressSpace_FxPakPro)
+	if err != nil {
+		return
+	}
+	if requiresMemoryMapping {
+		// need to know memory mapping of ROM:
+		s.memoryMapping, _, _, err = mapping.Detect(ctx, s.device, nil, nil)
+		if err != nil {
+			log.Printf("nwa: %s: could not detect memory mapping: %s\n", s.clientName, err)
+			err = nil
+			s.memoryMapping = sni.MemoryMapping_Unknown
+		}
+	}
+	return
+}
+
+func (s *session) requireCapability(capability sni.DeviceCapability) error {
+	if _, err := s.driver.HasCapabilities(capability); err != nil {
+		return &replyError{"invalid_command", err.Error()}
+	}
+	return nil
+}
+
+func (s *session) emulationStatus(ctx context.Context) (err error) {
+	// consoles without the capability are always running:
+	state, game := "running", ""
+	if s.requireCapability(sni.DeviceCapability_GetEmulationState) == nil {
+		var status devices.EmulationStatus
+		status, err = s.device.GetEmulationState(ctx)
+		if err != nil {
+			return
+		}
+
+		switch status.State {
+		case sni.EmulationState_Paused:
+			state = "paused"
+		case sni.EmulationState_NoContent, sni.EmulationState_InMenu:
+			state = "no_game"
+		}
+		game = path.Base(status.RomFileName)
+	}
+
+	s.writeASCII(pair{"state", state}, pair{"game", game})
+	return
+}
+
+func (s *session) gameInfo(ctx context.Context) (err error) {
+	var file string
+	if s.requireCapability(sni.DeviceCapability_FetchFields) == nil {
+		var values []string
+		values, err = s.device.FetchFields(ctx, sni.Field_RomFileName)
+		if err != nil {
+			return
+		}
+		file = values[0]
+	}
+
+	name := ""
+	if file != "" {
+		name = strings.TrimSuffix(path.Base(file), path.Ext(file))
+	}
+	s.writeASCII(pair{"type", "SNES"}, pair{"name", name}, pair{"file", file})
+	return
+}
+
+// parseNumber parses NWA numbers which are decimal or hexadecimal with a '$' prefix:
+func parseNumber(s string) (n uint32, err error) {
+	var v uint64
+	if strings.HasPrefix(s, "$") {
+		v, err = strconv.ParseUint(s[1:], 16, 32)
+	} else {
+		v, err = strconv.ParseUint(s, 0, 32)
+	}
+	n = uint32(v)
+	return
+}
+
+// loadedSize determines how much of a memory domain the loaded ROM uses from its header so that reads of a whole
+// domain do not transfer all 14MB of CARTROM:
+func (s *session) loadedSize(ctx context.Context, memoryType mapping.MemoryType, regionSize uint32) (size uint32, err error) {
+	if memoryType == mapping.MemoryTypeWRAM {
+		size = regionSize
+		return
+	}
+
+	var headerBytes []byte
+	_, _, headerBytes, err = mapping.Detect(ctx, s.device, nil, nil)
+	if err != nil {
+		if !devices.IsFatal(err) {
+			err = invalidArgument("no ROM header found to size %s; give an offset and size", memoryType)
+		}
+		return
+	}
+
+	header := snes.Header{}
+	err = header.ReadHeader(bytes.NewReader(headerBytes))
+	if err != nil {
+		return
+	}
+
+	romSize, sramSize := mapping.Sizes(&header)
+	switch memoryType {
+	case mapping.MemoryTypeROM:
+		size = romSize
+		if size == 0 {
+			size = regionSize
+		}
+	case mapping.MemoryTypeSRAM:
+		// 0 if the loaded ROM has no SRAM:
+		size = sramSize
+	}
+	if size > regionSize {
+		size = regionSize
+	}
+	return
+}
+
+// memoryRequests translates the arguments of CORE_READ and CORE_WRITE, a memory domain name followed by pairs of
+// offsets and sizes separated by ';', to addresses in the FX Pak Pro address space. A missing size extends to the end
+// of what the loaded ROM uses of the domain and no offset at all selects all of that.
+func (s *session) memoryRequests(ctx context.Context, args string) (addresses []devices.AddressTuple, sizes []int, err error) {
+	fields := strings.Split(args, ";")
+	memoryType := mapping.MemoryType(strings.TrimSpace(fields[0]))
+	if _, _, ok := mapping.PakAddressForMemoryType(memoryType, 0); !ok {
+		err = invalidArgument("unknown memory '%s'", memoryType)
+		return
+	}
+
+	fields = fields[1:]
+	if len(fields) == 0 {
+		fields = []string{"0"}
+	}
+	for i := 0; i < len(fields); i += 2 {
+		var offset, size uint32
+		offset, err = parseNumber(strings.TrimSpace(fields[i]))
+		if err != nil {
+			err = invalidArgument("bad offset '%s'", fields[i])
+			return
+		}
+
+		pakAddress, regionSize, ok := mapping.PakAddressForMemoryType(memoryType, offset)
+		if !ok {
+			err = invalidArgument("offset $%x is outside %s", offset, memoryType)
+			return
+		}
+
+		if i+1 < len(fields) {
+			size, err = parseNumber(strings.TrimSpace(fields[i+1]))
+			if err != nil {
+				err = invalidArgument("bad size '%s'", fields[i+1])
+				return
+			}
+			if size > regionSize-offset {
+				err = invalidArgument("$%x bytes at offset $%x exceed %s", size, offset, memoryType)
+				return
+			}
+		} else {
+			var loaded uint32
+			loaded, err = s.loadedSize(ctx, memoryType, regionSize)
+			if err != nil {
+				return
+			}
+			if offset >= loaded {
+				err = invalidArgument("offset $%x is outside the $%x bytes of %s the loaded ROM uses", offset, loaded, memoryType)
+				return
+			}
+			size = loaded - offset
+		}
+
+		addresses = append(addresses, devices.AddressTuple{
+			Address:       pakAddress,
+			AddressSpace:  sni.AddressSpace_FxPakPro,
+			MemoryMapping: s.memoryMapping,
+		})
+		sizes = append(sizes, int(size))
+	}
+	return
+}
+
+func (s *session) coreRead(ctx context.Context, args string) (err error) {
+	if err = s.requireCapability(sni.DeviceCapability_ReadMemory); err != nil {
+		return
+	}
+
+	var addresses []devices.AddressTuple
+	var sizes []int
+	addresses, sizes, err = s.memoryRequests(ctx, args)
+	if err != nil {
+		return
+	}
+
+	reads := make([]devices.MemoryReadRequest, len(addresses))
+	for i := range addresses {
+		reads[i] = devices.MemoryReadRequest{RequestAddress: addresses[i], Size: sizes[i]}
+	}
+
+	var rsps []devices.MemoryReadResponse
+	rsps, err = s.device.MultiReadMemory(ctx, reads...)
+	if err != nil {
+		return
+	}
+
+	var data []byte
+	for _, rsp := range rsps {
+		data = append(data, rsp.Data...)
+	}
+	s.writeBinary(data)
+	return
+}
+
+func (s *session) coreWrite(ctx context.Context, args string, data []byte) (err error) {
+	if err = s.requireCapability(sni.DeviceCapability_WriteMemory); err != nil {
+		return
+	}
+	if data == nil {
+		return &replyError{"protocol_error", "CORE_WRITE requires a binary argument; send it as bCORE_WRITE"}
+	}
+
+	var addresses []devices.AddressTuple
+	var sizes []int
+	addresses, sizes, err = s.memoryRequests(ctx, args)
+	if err != nil {
+		return
+	}
+
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	if total != len(data) {
+		return invalidArgument("binary argument has %d bytes but the offsets and sizes require %d", len(data), total)
+	}
+
+	writes := make([]devices.MemoryWriteRequest, len(addresses))
+	offs := 0
+	for i := range addresses {
+		writes[i] = devices.MemoryWriteRequest{RequestAddress: addresses[i], Data: data[offs : offs+sizes[i]]}
+		offs += sizes[i]
+	}
+
+	_, err = s.device.MultiWriteMemory(ctx, writes...)
+	if err != nil {
+		return
+	}
+	s.writeASCII()
+	return
+}
@@ -0,0 +1,210 @@
+package nwa
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"io"
+	"net"
+	"os"
+	"sni/devices"
+	"sni/devices/snes/drivers/emunwa"
+	"sni/devices/snes/drivers/mock"
+	"sni/protos/sni"
+	"strings"
+	"testing"
+	"time"
+)
+
+func init() {
+	_ = os.Setenv("SNI_MOCK_ENABLE", "1")
+	mock.DriverInit()
+}
+
+// serveTest serves NWA for the mock device and connects SNI's own NWA client to it:
+func serveTest(t *testing.T) (addr *net.TCPAddr, c *emunwa.Client) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go serveConn(conn)
+		}
+	}()
+
+	addr = l.Addr().(*net.TCPAddr)
+	c = emunwa.NewClient(addr, "test", time.Second*5)
+	c.MuteLog(true)
+	if err = c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = c.Close() })
+	return
+}
+
+func TestServer_MockDevice(t *testing.T) {
+	_, c := serveTest(t)
+
+	ctx := context.Background()
+	info, err := c.NWAEmulatorInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Name != emulatorName || len(info.Commands) != len(commands) {
+		t.Fatalf("NWAEmulatorInfo() = %+v", info)
+	}
+
+	// WRAM and SRAM through the SNES A-bus are translated to NWA memory domains and back:
+	data := []byte{1, 2, 3, 4}
+	writes := []devices.MemoryWriteRequest{
+		{
+			RequestAddress: devices.AddressTuple{Address: 0x7E0100, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+			Data:           data,
+		},
+		{
+			RequestAddress: devices.AddressTuple{Address: 0x700010, AddressSpace: sni.AddressSpace_SnesABus, MemoryMapping: sni.MemoryMapping_LoROM},
+			Data:           data,
+		},
+	}
+	if _, err = c.MultiWriteMemory(ctx, writes...); err != nil {
+		t.Fatal(err)
+	}
+
+	rsps, err := c.MultiReadMemory(
+		ctx,
+		devices.MemoryReadRequest{RequestAddress: writes[0].RequestAddress, Size: len(data)},
+		devices.MemoryReadRequest{RequestAddress: writes[1].RequestAddress, Size: len(data)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range rsps {
+		if !bytes.Equal(rsps[i].Data, data) {
+			t.Errorf("read[%d] = %x, want %x", i, rsps[i].Data, data)
+		}
+	}
+
+	state, err := c.GetEmulationState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state.State != sni.EmulationState_Running {
+		t.Errorf("GetEmulationState().State = %v, want %v", state.State, sni.EmulationState_Running)
+	}
+
+	// the mock device cannot reset:
+	var coded *devices.CodedError
+	err = c.ResetSystem(ctx)
+	if !errors.As(err, &coded) || coded.Code != codes.Unimplemented {
+		t.Errorf("ResetSystem() error = %v, want code %v", err, codes.Unimplemented)
+	}
+
+	memories, err := c.NWACoreMemories(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(memories) != len(memoryTypes) || memories[0] != (devices.NWACoreMemory{Name: "WRAM", Access: "rw", Size: 0x20000}) {
+		t.Errorf("NWACoreMemories() = %+v", memories)
+	}
+}
+
+// command sends a raw NWA command and returns the binary reply or, for ASCII replies, the reply text:
+func command(t *testing.T, conn net.Conn, r *bufio.Reader, line string) (data []byte, reply string) {
+	if _, err := conn.Write([]byte(line + "\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	kind, err := r.ReadByte()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kind == 0 {
+		var size uint32
+		if err = binary.Read(r, binary.BigEndian, &size); err != nil {
+			t.Fatal(err)
+		}
+		data = make([]byte, size)
+		if _, err = io.ReadFull(r, data); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	sb := strings.Builder{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line == "\n" {
+			break
+		}
+		sb.WriteString(line)
+	}
+	reply = sb.String()
+	return
+}
+
+func TestServer_WholeDomainRead(t *testing.T) {
+	addr, c := serveTest(t)
+
+	// a LoROM header at $7FB0 of CARTROM declaring 512KB of ROM and 8KB of SRAM:
+	header := make([]byte, 0x50)
+	header[0x25] = 0x20
+	header[0x27] = 0x09
+	header[0x28] = 0x03
+	binary.LittleEndian.PutUint16(header[0x4C:], 0x8000)
+
+	headerAddress := devices.AddressTuple{Address: 0x007FB0, AddressSpace: sni.AddressSpace_FxPakPro, MemoryMapping: sni.MemoryMapping_LoROM}
+	writeHeader := func(header []byte) {
+		if _, err := c.MultiWriteMemory(context.Background(), devices.MemoryWriteRequest{RequestAddress: headerAddress, Data: header}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the mock device is shared with other tests:
+	writeHeader(make([]byte, 0x50))
+	t.Cleanup(func() { writeHeader(make([]byte, 0x50)) })
+
+	conn, err := net.DialTCP("tcp", nil, addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+
+	// without a ROM header there is no telling how much of CARTROM is used:
+	if _, reply := command(t, conn, r, "CORE_READ CARTROM"); !strings.Contains(reply, "error:invalid_argument") {
+		t.Errorf("CORE_READ CARTROM without a header = %q, want invalid_argument", reply)
+	}
+
+	writeHeader(header)
+	if data, reply := command(t, conn, r, "CORE_READ CARTROM"); len(data) != 0x80000 {
+		t.Errorf("CORE_READ CARTROM = $%x bytes %q, want $80000 bytes", len(data), reply)
+	}
+	if data, reply := command(t, conn, r, "CORE_READ SRAM;$1000"); len(data) != 0x1000 {
+		t.Errorf("CORE_READ SRAM;$1000 = $%x bytes %q, want $1000 bytes", len(data), reply)
+	}
+	if data, reply := command(t, conn, r, "CORE_READ CARTROM;0;$100000"); len(data) != 0x100000 {
+		t.Errorf("CORE_READ CARTROM;0;$100000 = $%x bytes %q, want $100000 bytes", len(data), reply)
+	}
+
+	// a binary argument larger than any memory domain is refused before it is allocated and the client disconnected:
+	block := []byte("bCORE_WRITE WRAM;0;1\n\x00")
+	block = binary.BigEndian.AppendUint32(block, 0xFFFFFFFF)
+	if _, err = conn.Write(block); err != nil {
+		t.Fatal(err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	if _, err = r.ReadByte(); err != io.EOF {
+		t.Errorf("read after oversized binary argument error = %v, want %v", err, io.EOF)
+	}
+}
@@ -245,6 +245,15 @@ serverLoop:
 			return true
 		}
 
+		hasFlag := func(flag string) bool {
+			for _, f := range cmd.Flags {
+				if strings.EqualFold(strings.TrimSpace(f), flag) {
+					return true
+				}
+			}
+			return false
+		}
+
 		switch cmd.Opcode {
 		case "DeviceList":
 			descriptors := make([]devices.DeviceDescriptor, 0, 10)
@@ -338,12 +347,17 @@ serverLoop:
 				sni.Field_DeviceVersion,
 				sni.Field_DeviceName,
 				sni.Field_RomFileName,
+				sni.Field_DeviceFeatures,
 			)
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %v; falling back to default Info values\n", clientName, cmd.Opcode, err)
 				results.Results = []string{"1.9.0-usb-v9", "SD2SNES", "No Info"}
 			} else {
 				results.Results = []string{fields[0], fields[1], fields[2]}
+				// feature flags follow as individual results:
+				if fields[3] != "" {
+					results.Results = append(results.Results, strings.Split(fields[3], ",")...)
+				}
 			}
 
 			if !replyJson() {
@@ -386,6 +400,7 @@ serverLoop:
 				}
 
 				var addr32 uint32
+				addressSpace := sni.AddressSpace_FxPakPro
 				space := strings.TrimSpace(strings.ToUpper(cmd.Space))
 				switch space {
 				case "SNES":
@@ -395,6 +410,14 @@ serverLoop:
 					// dirty dirty hack to put the CMD address space into the FxPakPro space as some sort of subspace:
 					addr32 = uint32(addr&0x00_FFFFFF) | 0x01_000000
 					break
+				case "MSU":
+					addr32 = uint32(addr & 0x00_FFFFFF)
+					addressSpace = sni.AddressSpace_FxPakProMSU
+					break
+				case "CONFIG":
+					addr32 = uint32(addr & 0x00_FFFFFF)
+					addressSpace = sni.AddressSpace_FxPakProConfig
+					break
 				default:
 					log.Printf("usb2snes: %s: %s: unrecognized space '%s'\n", clientName, cmd.Opcode, space)
 					break serverLoop
@@ -403,7 +426,7 @@ serverLoop:
 				reqs[i] = devices.MemoryReadRequest{
 					RequestAddress: devices.AddressTuple{
 						Address:       addr32,
-						AddressSpace:  sni.AddressSpace_FxPakPro,
+						AddressSpace:  addressSpace,
 						MemoryMapping: deviceMemoryMapping,
 					},
 					Size: int(size),
@@ -503,6 +526,7 @@ serverLoop:
 				}
 
 				var addr32 uint32
+				addressSpace := sni.AddressSpace_FxPakPro
 				space := strings.TrimSpace(strings.ToUpper(cmd.Space))
 				switch space {
 				case "SNES":
@@ -512,6 +536,14 @@ serverLoop:
 					// dirty dirty hack to put the CMD address space into the FxPakPro space as some sort of subspace:
 					addr32 = uint32(addr&0x00_FFFFFF) | 0x01_000000
 					break
+				case "MSU":
+					addr32 = uint32(addr & 0x00_FFFFFF)
+					addressSpace = sni.AddressSpace_FxPakProMSU
+					break
+				case "CONFIG":
+					addr32 = uint32(addr & 0x00_FFFFFF)
+					addressSpace = sni.AddressSpace_FxPakProConfig
+					break
 				default:
 					log.Printf("usb2snes: %s: %s: unrecognized space '%s'\n", clientName, cmd.Opcode, space)
 					break serverLoop
@@ -520,7 +552,7 @@ serverLoop:
 				reqs[i] = devices.MemoryWriteRequest{
 					RequestAddress: devices.AddressTuple{
 						Address:       addr32,
-						AddressSpace:  sni.AddressSpace_FxPakPro,
+						AddressSpace:  addressSpace,
 						MemoryMapping: deviceMemoryMapping,
 					},
 					Data: make([]byte, size),
@@ -551,9 +583,14 @@ serverLoop:
 				}
 			}
 
-			// issue the read request:
+			// issue the write request:
 			var rsps []devices.MemoryWriteResponse
-			rsps, err = device.MultiWriteMemory(context.Background(), reqs...)
+			verify := hasFlag("VERIFY")
+			if verify {
+				rsps, err = devices.MultiWriteMemoryVerified(context.Background(), device, reqs...)
+			} else {
+				rsps, err = device.MultiWriteMemory(context.Background(), reqs...)
+			}
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
@@ -562,7 +599,18 @@ serverLoop:
 				log.Printf("usb2snes: %s: %s REPLY: %+v\n", clientName, cmd.Opcode, rsps)
 			}
 
-			_ = rsps
+			if verify {
+				// reply with one result per written range; empty if the range verified:
+				results.Results = make([]string, len(rsps))
+				for i, rsp := range rsps {
+					if rsp.VerifyError != nil {
+						results.Results[i] = rsp.VerifyError.Error()
+					}
+				}
+				if !replyJson() {
+					break serverLoop
+				}
+			}
 			break
 
 		case "Reset":
@@ -762,9 +810,41 @@ serverLoop:
 				}
 			}
 
+			options := devices.PutFileOptions{
+				Verify: hasFlag("VERIFY"),
+				Atomic: hasFlag("ATOMIC"),
+			}
+
 			var n uint32
 			wsr := &wsReader{r}
-			n, err = device.PutFile(context.Background(), cmd.Operands[0], size, wsr, progress)
+			if hasFlag("RESUME") {
+				// reply with the number of bytes already staged; the client then sends only the remainder of the file:
+				var staged uint32
+				staged, err = devices.StagedUploadSize(attachedUri, cmd.Operands[0], size)
+				if err != nil {
+					log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+					break serverLoop
+				}
+
+				results.Results = []string{fmt.Sprintf("%x", staged)}
+				if !replyJson() {
+					break serverLoop
+				}
+
+				staged, err = devices.StageUpload(attachedUri, cmd.Operands[0], size, staged, wsr, size-staged)
+				if err != nil {
+					log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
+					break serverLoop
+				}
+				if staged < size {
+					log.Printf("usb2snes: %s: %s interrupted after $%x of $%x bytes\n", clientName, cmd.Opcode, staged, size)
+					break serverLoop
+				}
+
+				n, err = devices.PutStagedUpload(context.Background(), device, attachedUri, cmd.Operands[0], size, options, progress)
+			} else {
+				n, err = devices.PutFileWithOptions(context.Background(), device, cmd.Operands[0], size, wsr, options, progress)
+			}
 			if err != nil {
 				log.Printf("usb2snes: %s: %s error: %s\n", clientName, cmd.Opcode, err)
 				break serverLoop
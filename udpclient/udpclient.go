@@ -136,6 +136,11 @@ func (c *UDPClient) log(fmt string, args ...interface{}) {
 	log.Printf(fmt, args...)
 }
 
+// Conn returns the underlying connection, e.g. for a dedicated reader goroutine; nil if not connected.
+func (c *UDPClient) Conn() *net.UDPConn {
+	return c.c
+}
+
 func (c *UDPClient) LocalAddr() *net.UDPAddr {
 	if c.c == nil {
 		return nil